@@ -0,0 +1,222 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// apiRequestIDHeaders are the headers in which the OpenStack services return
+// the ID of a request, in order of preference.
+var apiRequestIDHeaders = []string{
+	"X-Openstack-Request-Id",
+	"X-Compute-Request-Id",
+	"X-Trans-Id",
+}
+
+// apiRequestFailure describes a request to an OpenStack API which returned
+// an error status.
+type apiRequestFailure struct {
+	RequestID string
+	Service   string
+	Region    string
+	Method    string
+	Path      string
+	Status    int
+}
+
+func (f apiRequestFailure) String() string {
+	requestID := f.RequestID
+	if requestID == "" {
+		requestID = "unknown"
+	}
+
+	details := []string{}
+	if f.Service != "" {
+		details = append(details, "service: "+f.Service)
+	}
+	if f.Region != "" {
+		details = append(details, "region: "+f.Region)
+	}
+	details = append(details, fmt.Sprintf("request: %s %s", f.Method, f.Path))
+	details = append(details, fmt.Sprintf("status: %d", f.Status))
+
+	return fmt.Sprintf("Last failed OpenStack API request ID: %s (%s)",
+		requestID, strings.Join(details, ", "))
+}
+
+// apiErrorRecorder keeps track of the endpoints used by a Config and of the
+// last request to them which failed.
+type apiErrorRecorder struct {
+	mu        sync.Mutex
+	endpoints map[string]gophercloud.EndpointOpts
+	last      *apiRequestFailure
+}
+
+func newAPIErrorRecorder() *apiErrorRecorder {
+	return &apiErrorRecorder{
+		endpoints: make(map[string]gophercloud.EndpointOpts),
+	}
+}
+
+func (r *apiErrorRecorder) addEndpoint(url string, eo gophercloud.EndpointOpts) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.endpoints[url] = eo
+}
+
+func (r *apiErrorRecorder) record(request *http.Request, response *http.Response) {
+	failure := apiRequestFailure{
+		Method: request.Method,
+		Path:   request.URL.Path,
+		Status: response.StatusCode,
+	}
+
+	for _, header := range apiRequestIDHeaders {
+		if v := response.Header.Get(header); v != "" {
+			failure.RequestID = v
+			break
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Find the service of the request from the longest endpoint it uses.
+	url, longest := request.URL.String(), 0
+	for endpoint, eo := range r.endpoints {
+		if strings.HasPrefix(url, endpoint) && len(endpoint) > longest {
+			failure.Service = eo.Type
+			failure.Region = eo.Region
+			longest = len(endpoint)
+		}
+	}
+
+	log.Printf("[DEBUG] OpenStack API request failed: %s", failure)
+	r.last = &failure
+}
+
+// annotate adds the details of the last failed request to an error.
+func (r *apiErrorRecorder) annotate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.last == nil {
+		return err
+	}
+
+	return fmt.Errorf("%s\n\n%s", err, r.last)
+}
+
+// APIErrorRoundTripper satisfies the http.RoundTripper interface and records
+// the requests which returned an error status.
+type APIErrorRoundTripper struct {
+	Rt       http.RoundTripper
+	recorder *apiErrorRecorder
+}
+
+// RoundTrip performs a round-trip HTTP request and records it if it failed.
+func (rt *APIErrorRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := rt.Rt.RoundTrip(request)
+	if response != nil && response.StatusCode >= 400 {
+		rt.recorder.record(request, response)
+	}
+
+	return response, err
+}
+
+// withAPIErrorRecorder returns a copy of the Config whose clients record
+// their failed requests. The copy shares the authentication of the original.
+func (c *Config) withAPIErrorRecorder() (*Config, *apiErrorRecorder) {
+	recorder := newAPIErrorRecorder()
+	if c.osClient == nil {
+		return c, recorder
+	}
+
+	config := *c
+	config.osClient = recordedProviderClient(c.osClient, recorder)
+	config.apiErrors = recorder
+
+	return &config, recorder
+}
+
+// recordedProviderClient returns a copy of a provider client whose failed
+// requests are recorded by recorder.
+func recordedProviderClient(original *gophercloud.ProviderClient, recorder *apiErrorRecorder) *gophercloud.ProviderClient {
+	client := *original
+
+	client.HTTPClient.Transport = &APIErrorRoundTripper{
+		Rt:       original.HTTPClient.Transport,
+		recorder: recorder,
+	}
+
+	// Re-authentication replaces the token and the catalog of the original
+	// client, so always look them up there.
+	client.EndpointLocator = func(eo gophercloud.EndpointOpts) (string, error) {
+		url, err := original.EndpointLocator(eo)
+		if err == nil {
+			recorder.addEndpoint(url, eo)
+		}
+		return url, err
+	}
+
+	if original.ReauthFunc != nil {
+		client.ReauthFunc = func() error {
+			err := original.ReauthFunc()
+			client.TokenID = original.TokenID
+			return err
+		}
+	}
+
+	return &client
+}
+
+// withAPIErrorDetails wraps the CRUD functions of a resource so their errors
+// include the request ID, service, region and path of the last failed
+// OpenStack API request.
+func withAPIErrorDetails(r *schema.Resource) {
+	r.Create = apiErrorDetailsFunc(r.Create)
+	r.Read = apiErrorDetailsFunc(r.Read)
+	r.Update = apiErrorDetailsFunc(r.Update)
+	r.Delete = apiErrorDetailsFunc(r.Delete)
+
+	if r.Exists != nil {
+		exists := r.Exists
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			config, ok := meta.(*Config)
+			if !ok {
+				return exists(d, meta)
+			}
+
+			config, recorder := config.withAPIErrorRecorder()
+			ok, err := exists(d, config)
+			return ok, recorder.annotate(err)
+		}
+	}
+}
+
+func apiErrorDetailsFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config, ok := meta.(*Config)
+		if !ok {
+			return f(d, meta)
+		}
+
+		config, recorder := config.withAPIErrorRecorder()
+		return recorder.annotate(f(d, config))
+	}
+}
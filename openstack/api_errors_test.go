@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+type testAPIErrorTransport struct {
+	status int
+	header http.Header
+}
+
+func (t testAPIErrorTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: t.status, Header: t.header, Request: request}, nil
+}
+
+func TestAPIErrorRoundTripper(t *testing.T) {
+	recorder := newAPIErrorRecorder()
+	recorder.addEndpoint("https://compute.example.com/v2.1/", gophercloud.EndpointOpts{
+		Type:   "compute",
+		Region: "RegionOne",
+	})
+
+	header := http.Header{}
+	header.Set("X-Compute-Request-Id", "req-compute")
+	header.Set("X-Openstack-Request-Id", "req-openstack")
+
+	rt := &APIErrorRoundTripper{
+		Rt:       testAPIErrorTransport{status: http.StatusOK, header: header},
+		recorder: recorder,
+	}
+
+	request, _ := http.NewRequest("POST", "https://compute.example.com/v2.1/servers", nil)
+	if _, err := rt.RoundTrip(request); err != nil {
+		t.Fatal(err)
+	}
+
+	err := fmt.Errorf("Error creating OpenStack server")
+	if recorder.annotate(err) != err {
+		t.Fatalf("expected the error to be unchanged without a failed request")
+	}
+
+	rt.Rt = testAPIErrorTransport{status: http.StatusBadRequest, header: header}
+	if _, err := rt.RoundTrip(request); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Last failed OpenStack API request ID: req-openstack (service: compute, " +
+		"region: RegionOne, request: POST /v2.1/servers, status: 400)"
+	if msg := recorder.annotate(err).Error(); !strings.HasSuffix(msg, expected) {
+		t.Fatalf("unexpected error message: %s", msg)
+	}
+
+	if recorder.annotate(nil) != nil {
+		t.Fatalf("expected no error")
+	}
+}
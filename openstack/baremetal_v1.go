@@ -0,0 +1,98 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// baremetalV1Microversion is the Bare Metal API version the requests are made
+// with. Deploy templates were added in 1.55.
+const baremetalV1Microversion = "1.55"
+
+func baremetalV1RequestOpts(okCodes ...int) *gophercloud.RequestOpts {
+	return &gophercloud.RequestOpts{
+		OkCodes: okCodes,
+		MoreHeaders: map[string]string{
+			"X-OpenStack-Ironic-API-Version": baremetalV1Microversion,
+		},
+	}
+}
+
+// BaremetalDeployStep is a step of a Bare Metal deploy template.
+type BaremetalDeployStep struct {
+	Interface string                 `json:"interface"`
+	Step      string                 `json:"step"`
+	Args      map[string]interface{} `json:"args"`
+	Priority  int                    `json:"priority"`
+}
+
+// BaremetalDeployTemplate is a Bare Metal deploy template.
+type BaremetalDeployTemplate struct {
+	UUID  string                 `json:"uuid,omitempty"`
+	Name  string                 `json:"name"`
+	Steps []BaremetalDeployStep  `json:"steps"`
+	Extra map[string]interface{} `json:"extra,omitempty"`
+}
+
+func baremetalDeployTemplateV1Create(client *gophercloud.ServiceClient, template BaremetalDeployTemplate) (*BaremetalDeployTemplate, error) {
+	var res BaremetalDeployTemplate
+	_, err := client.Post(client.ServiceURL("deploy_templates"), template, &res, baremetalV1RequestOpts(201))
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func baremetalDeployTemplateV1Get(client *gophercloud.ServiceClient, id string) (*BaremetalDeployTemplate, error) {
+	var res BaremetalDeployTemplate
+	_, err := client.Get(client.ServiceURL("deploy_templates", id), &res, baremetalV1RequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func baremetalDeployTemplateV1Update(client *gophercloud.ServiceClient, id string, patches []JSONPatch) error {
+	var res BaremetalDeployTemplate
+	_, err := client.Patch(client.ServiceURL("deploy_templates", id), patches, &res, baremetalV1RequestOpts(200))
+	return err
+}
+
+func baremetalDeployTemplateV1Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("deploy_templates", id), baremetalV1RequestOpts(204))
+	return err
+}
+
+func baremetalNodeTraitsV1Get(client *gophercloud.ServiceClient, nodeID string) ([]string, error) {
+	var res struct {
+		Traits []string `json:"traits"`
+	}
+	_, err := client.Get(client.ServiceURL("nodes", nodeID, "traits"), &res, baremetalV1RequestOpts(200))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Traits, nil
+}
+
+// baremetalNodeTraitsV1Set replaces all traits of a node.
+func baremetalNodeTraitsV1Set(client *gophercloud.ServiceClient, nodeID string, traits []string) error {
+	b := map[string]interface{}{
+		"traits": traits,
+	}
+	resp, err := client.Put(client.ServiceURL("nodes", nodeID, "traits"), b, nil, baremetalV1RequestOpts(204))
+	if err != nil {
+		return err
+	}
+
+	// The response has no body to decode, so it has to be closed here.
+	resp.Body.Close()
+	return nil
+}
+
+// baremetalNodeTraitsV1Delete removes all traits of a node.
+func baremetalNodeTraitsV1Delete(client *gophercloud.ServiceClient, nodeID string) error {
+	_, err := client.Delete(client.ServiceURL("nodes", nodeID, "traits"), baremetalV1RequestOpts(204))
+	return err
+}
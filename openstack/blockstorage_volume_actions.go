@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// blockStorageVolumeAction runs a volume action. os-set_bootable and
+// os-update_readonly_flag are available in the v1 and v2 Block Storage APIs.
+func blockStorageVolumeAction(client *gophercloud.ServiceClient, id string, b map[string]interface{}) error {
+	_, err := client.Post(client.ServiceURL("volumes", id, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return err
+}
+
+func blockStorageVolumeSetBootable(client *gophercloud.ServiceClient, id string, bootable bool) error {
+	return blockStorageVolumeAction(client, id, map[string]interface{}{
+		"os-set_bootable": map[string]interface{}{
+			"bootable": bootable,
+		},
+	})
+}
+
+func blockStorageVolumeSetReadOnly(client *gophercloud.ServiceClient, id string, readOnly bool) error {
+	return blockStorageVolumeAction(client, id, map[string]interface{}{
+		"os-update_readonly_flag": map[string]interface{}{
+			"readonly": readOnly,
+		},
+	})
+}
+
+// resourceBlockStorageVolumeFlags sets the bootable and read_only flags of a
+// volume when they changed. On creation, only the flags set to true are
+// changed.
+func resourceBlockStorageVolumeFlags(client *gophercloud.ServiceClient, d *schema.ResourceData) error {
+	if d.HasChange("bootable") {
+		bootable := d.Get("bootable").(bool)
+		log.Printf("[DEBUG] Setting bootable of volume %s to %t", d.Id(), bootable)
+		if err := blockStorageVolumeSetBootable(client, d.Id(), bootable); err != nil {
+			return fmt.Errorf("Error setting bootable of OpenStack volume %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("read_only") {
+		readOnly := d.Get("read_only").(bool)
+		log.Printf("[DEBUG] Setting read_only of volume %s to %t", d.Id(), readOnly)
+		if err := blockStorageVolumeSetReadOnly(client, d.Id(), readOnly); err != nil {
+			return fmt.Errorf("Error setting read_only of OpenStack volume %s: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// blockStorageVolumeReadOnly returns whether a volume is read-only, and its
+// metadata without the readonly key. Cinder shows the read-only flag, which
+// is admin metadata, in the metadata of the volume.
+func blockStorageVolumeReadOnly(metadata map[string]string) (bool, map[string]string) {
+	m := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if k != "readonly" {
+			m[k] = v
+		}
+	}
+
+	return strings.EqualFold(metadata["readonly"], "true"), m
+}
@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+func blockStorageVolumeMetadataV3Get(client *gophercloud.ServiceClient, volumeID string) (map[string]string, error) {
+	var res struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	_, err := client.Get(client.ServiceURL("volumes", volumeID, "metadata"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Metadata, nil
+}
+
+// blockStorageVolumeMetadataV3Merge adds the given metadata to a volume,
+// updating the keys which already exist and keeping the others.
+func blockStorageVolumeMetadataV3Merge(client *gophercloud.ServiceClient, volumeID string, metadata map[string]string) error {
+	b := map[string]interface{}{
+		"metadata": metadata,
+	}
+
+	var res map[string]interface{}
+	_, err := client.Post(client.ServiceURL("volumes", volumeID, "metadata"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func blockStorageVolumeMetadataV3DeleteKey(client *gophercloud.ServiceClient, volumeID, key string) error {
+	_, err := client.Delete(client.ServiceURL("volumes", volumeID, "metadata", key), &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
@@ -0,0 +1,92 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageSchedulerHintsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"different_host": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"same_host": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"local_to_instance": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceBlockStorageSchedulerHints(d *schema.ResourceData) (VolumeSchedulerHints, bool) {
+	var hints VolumeSchedulerHints
+
+	schedulerHintsRaw := d.Get("scheduler_hints").([]interface{})
+	if len(schedulerHintsRaw) == 0 || schedulerHintsRaw[0] == nil {
+		return hints, false
+	}
+
+	rawHints := schedulerHintsRaw[0].(map[string]interface{})
+	for _, v := range rawHints["different_host"].([]interface{}) {
+		hints.DifferentHost = append(hints.DifferentHost, v.(string))
+	}
+	for _, v := range rawHints["same_host"].([]interface{}) {
+		hints.SameHost = append(hints.SameHost, v.(string))
+	}
+	hints.LocalToInstance = rawHints["local_to_instance"].(string)
+
+	return hints, true
+}
+
+// resourceBlockStorageSchedulerHintsCheckAZ makes sure a volume which must be
+// local to an instance is requested in the availability zone of the
+// instance, since Cinder would otherwise fail to schedule it only after the
+// volume was created.
+func resourceBlockStorageSchedulerHintsCheckAZ(d *schema.ResourceData, meta interface{}, hints VolumeSchedulerHints) error {
+	availabilityZone := d.Get("availability_zone").(string)
+	if hints.LocalToInstance == "" || availabilityZone == "" {
+		return nil
+	}
+
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	var serverWithAZ struct {
+		servers.Server
+		availabilityzones.ServerExt
+	}
+	err = servers.Get(computeClient, hints.LocalToInstance).ExtractInto(&serverWithAZ)
+	if err != nil {
+		return fmt.Errorf("Error retrieving instance %s for local_to_instance: %s", hints.LocalToInstance, err)
+	}
+
+	if serverWithAZ.AvailabilityZone != "" && serverWithAZ.AvailabilityZone != availabilityZone {
+		return fmt.Errorf("The volume must be local to instance %s, which is in availability zone %s, "+
+			"but availability_zone is %s", hints.LocalToInstance, serverWithAZ.AvailabilityZone, availabilityZone)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
+)
+
+// BlockStorageVolumeListOpts adds the metadata filter, which the vendored
+// gophercloud drops from the query string, to volumes.ListOpts. The v2 volume
+// requests are compatible with the Cinder v3 API.
+type BlockStorageVolumeListOpts struct {
+	volumes.ListOpts
+}
+
+// ToVolumeListQuery formats a BlockStorageVolumeListOpts into a query string.
+func (opts BlockStorageVolumeListOpts) ToVolumeListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts.ListOpts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+	if len(opts.Metadata) > 0 {
+		metadata, err := json.Marshal(opts.Metadata)
+		if err != nil {
+			return "", err
+		}
+		params.Set("metadata", string(metadata))
+	}
+
+	return (&url.URL{RawQuery: params.Encode()}).String(), nil
+}
+
+func blockStorageVolumeV3List(client *gophercloud.ServiceClient, opts BlockStorageVolumeListOpts) ([]volumes.Volume, error) {
+	allPages, err := volumes.List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return volumes.ExtractVolumes(allPages)
+}
@@ -0,0 +1,35 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
+)
+
+func TestBlockStorageVolumeListOptsToVolumeListQuery(t *testing.T) {
+	opts := BlockStorageVolumeListOpts{
+		volumes.ListOpts{
+			Status:   "available",
+			Metadata: map[string]string{"role": "orphan"},
+		},
+	}
+
+	q, err := opts.ToVolumeListQuery()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := "?metadata=%7B%22role%22%3A%22orphan%22%7D&status=available"
+	if q != expected {
+		t.Fatalf("expected %s, got %s", expected, q)
+	}
+
+	q, err = BlockStorageVolumeListOpts{}.ToVolumeListQuery()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if q != "" {
+		t.Fatalf("expected an empty query, got %s", q)
+	}
+}
@@ -0,0 +1,46 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// computeConsoleV2Actions maps the supported console types to the server
+// action which returns their URL.
+var computeConsoleV2Actions = map[string]string{
+	"novnc":       "os-getVNCConsole",
+	"xvpvnc":      "os-getVNCConsole",
+	"spice-html5": "os-getSPICEConsole",
+	"rdp-html5":   "os-getRDPConsole",
+	"serial":      "os-getSerialConsole",
+}
+
+// ComputeConsole is a remote console of an instance.
+type ComputeConsole struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+func computeConsoleV2Get(client *gophercloud.ServiceClient, instanceID, consoleType string) (*ComputeConsole, error) {
+	action, ok := computeConsoleV2Actions[consoleType]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported console type: %s", consoleType)
+	}
+
+	reqBody := map[string]interface{}{
+		action: map[string]interface{}{"type": consoleType},
+	}
+
+	var res struct {
+		Console ComputeConsole `json:"console"`
+	}
+	_, err := client.Post(client.ServiceURL("servers", instanceID, "action"), reqBody, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Console, nil
+}
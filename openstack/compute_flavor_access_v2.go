@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ComputeFlavorAccess grants a project access to a private flavor.
+type ComputeFlavorAccess struct {
+	FlavorID string `json:"flavor_id"`
+	TenantID string `json:"tenant_id"`
+}
+
+func computeFlavorAccessV2List(client *gophercloud.ServiceClient, flavorID string) ([]ComputeFlavorAccess, error) {
+	var res struct {
+		FlavorAccess []ComputeFlavorAccess `json:"flavor_access"`
+	}
+	_, err := client.Get(client.ServiceURL("flavors", flavorID, "os-flavor-access"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.FlavorAccess, nil
+}
+
+func computeFlavorAccessV2Add(client *gophercloud.ServiceClient, flavorID, tenantID string) error {
+	b := map[string]interface{}{
+		"addTenantAccess": map[string]string{"tenant": tenantID},
+	}
+
+	// The response lists the projects which have access to the flavor.
+	var res struct {
+		FlavorAccess []ComputeFlavorAccess `json:"flavor_access"`
+	}
+	_, err := client.Post(client.ServiceURL("flavors", flavorID, "action"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func computeFlavorAccessV2Remove(client *gophercloud.ServiceClient, flavorID, tenantID string) error {
+	b := map[string]interface{}{
+		"removeTenantAccess": map[string]string{"tenant": tenantID},
+	}
+
+	// The response lists the projects which have access to the flavor.
+	var res struct {
+		FlavorAccess []ComputeFlavorAccess `json:"flavor_access"`
+	}
+	_, err := client.Post(client.ServiceURL("flavors", flavorID, "action"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
@@ -0,0 +1,196 @@
+package openstack
+
+import (
+	"sort"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ComputeHypervisor is a hypervisor with its resource usage.
+type ComputeHypervisor struct {
+	HypervisorHostname string `json:"hypervisor_hostname"`
+	State              string `json:"state"`
+	Status             string `json:"status"`
+	VCPUs              int    `json:"vcpus"`
+	VCPUsUsed          int    `json:"vcpus_used"`
+	MemoryMB           int    `json:"memory_mb"`
+	MemoryMBUsed       int    `json:"memory_mb_used"`
+	LocalGB            int    `json:"local_gb"`
+	LocalGBUsed        int    `json:"local_gb_used"`
+	Service            struct {
+		Host string `json:"host"`
+	} `json:"service"`
+}
+
+// ComputeCapacityRequest is the size of the instances to place, and the
+// allocation ratios the hypervisors are overcommitted with.
+type ComputeCapacityRequest struct {
+	VCPUs               int
+	RAM                 int
+	Disk                int
+	CPUAllocationRatio  float64
+	RAMAllocationRatio  float64
+	DiskAllocationRatio float64
+}
+
+// ComputeCapacity is the free capacity of a group of hypervisors, such as an
+// availability zone.
+type ComputeCapacity struct {
+	Name      string
+	Hosts     []string
+	Instances int
+	FreeVCPUs int
+	FreeRAM   int
+	FreeDisk  int
+}
+
+// computeHypervisorsV2List returns the hypervisors with their resource usage.
+// It is admin-only by default, as are the availability zone detail and
+// aggregate requests below.
+func computeHypervisorsV2List(client *gophercloud.ServiceClient) ([]ComputeHypervisor, error) {
+	var res struct {
+		Hypervisors []ComputeHypervisor `json:"hypervisors"`
+	}
+	_, err := client.Get(client.ServiceURL("os-hypervisors", "detail"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Hypervisors, nil
+}
+
+// computeAvailabilityZoneHostsV2 returns the compute hosts of the available
+// availability zones, keyed by zone.
+func computeAvailabilityZoneHostsV2(client *gophercloud.ServiceClient) (map[string][]string, error) {
+	var res struct {
+		AvailabilityZoneInfo []struct {
+			ZoneName  string `json:"zoneName"`
+			ZoneState struct {
+				Available bool `json:"available"`
+			} `json:"zoneState"`
+			Hosts map[string]map[string]interface{} `json:"hosts"`
+		} `json:"availabilityZoneInfo"`
+	}
+	_, err := client.Get(client.ServiceURL("os-availability-zone", "detail"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := make(map[string][]string)
+	for _, zone := range res.AvailabilityZoneInfo {
+		if !zone.ZoneState.Available {
+			continue
+		}
+
+		for host, services := range zone.Hosts {
+			if _, ok := services["nova-compute"]; ok {
+				zones[zone.ZoneName] = append(zones[zone.ZoneName], host)
+			}
+		}
+	}
+
+	return zones, nil
+}
+
+// computeAggregateHostsV2 returns the hosts of the host aggregates, keyed by
+// aggregate.
+func computeAggregateHostsV2(client *gophercloud.ServiceClient) (map[string][]string, error) {
+	var res struct {
+		Aggregates []struct {
+			Name  string   `json:"name"`
+			Hosts []string `json:"hosts"`
+		} `json:"aggregates"`
+	}
+	_, err := client.Get(client.ServiceURL("os-aggregates"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregates := make(map[string][]string)
+	for _, aggregate := range res.Aggregates {
+		aggregates[aggregate.Name] = aggregate.Hosts
+	}
+
+	return aggregates, nil
+}
+
+// computeHypervisorV2Instances returns how many instances of the requested
+// size fit on a hypervisor, along with its free resources. Hypervisors which
+// are down or disabled have no capacity.
+func computeHypervisorV2Instances(h ComputeHypervisor, req ComputeCapacityRequest) (int, int, int, int) {
+	freeVCPUs := int(float64(h.VCPUs)*req.CPUAllocationRatio) - h.VCPUsUsed
+	freeRAM := int(float64(h.MemoryMB)*req.RAMAllocationRatio) - h.MemoryMBUsed
+	freeDisk := int(float64(h.LocalGB)*req.DiskAllocationRatio) - h.LocalGBUsed
+	if freeVCPUs < 0 {
+		freeVCPUs = 0
+	}
+	if freeRAM < 0 {
+		freeRAM = 0
+	}
+	if freeDisk < 0 {
+		freeDisk = 0
+	}
+
+	if h.State != "up" || h.Status != "enabled" {
+		return 0, freeVCPUs, freeRAM, freeDisk
+	}
+
+	instances := -1
+	fit := func(free, requested int) {
+		if requested <= 0 {
+			return
+		}
+		if n := free / requested; instances < 0 || n < instances {
+			instances = n
+		}
+	}
+	fit(freeVCPUs, req.VCPUs)
+	fit(freeRAM, req.RAM)
+	fit(freeDisk, req.Disk)
+
+	if instances < 0 {
+		instances = 0
+	}
+
+	return instances, freeVCPUs, freeRAM, freeDisk
+}
+
+// computeCapacityV2 sums the capacity of the hypervisors of each group of
+// hosts. The groups are sorted by the number of instances which fit in them,
+// the largest first.
+func computeCapacityV2(hypervisors []ComputeHypervisor, groups map[string][]string, req ComputeCapacityRequest) []ComputeCapacity {
+	hostHypervisors := make(map[string][]ComputeHypervisor)
+	for _, h := range hypervisors {
+		hostHypervisors[h.Service.Host] = append(hostHypervisors[h.Service.Host], h)
+	}
+
+	capacities := make([]ComputeCapacity, 0, len(groups))
+	for name, hosts := range groups {
+		capacity := ComputeCapacity{
+			Name:  name,
+			Hosts: append([]string{}, hosts...),
+		}
+		sort.Strings(capacity.Hosts)
+
+		for _, host := range hosts {
+			for _, h := range hostHypervisors[host] {
+				instances, freeVCPUs, freeRAM, freeDisk := computeHypervisorV2Instances(h, req)
+				capacity.Instances += instances
+				capacity.FreeVCPUs += freeVCPUs
+				capacity.FreeRAM += freeRAM
+				capacity.FreeDisk += freeDisk
+			}
+		}
+
+		capacities = append(capacities, capacity)
+	}
+
+	sort.Slice(capacities, func(i, j int) bool {
+		if capacities[i].Instances != capacities[j].Instances {
+			return capacities[i].Instances > capacities[j].Instances
+		}
+		return capacities[i].Name < capacities[j].Name
+	})
+
+	return capacities
+}
@@ -0,0 +1,82 @@
+package openstack
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComputeCapacityV2(t *testing.T) {
+	hypervisor := func(host, state, status string, vcpusUsed, memoryMBUsed int) ComputeHypervisor {
+		h := ComputeHypervisor{
+			State:        state,
+			Status:       status,
+			VCPUs:        16,
+			VCPUsUsed:    vcpusUsed,
+			MemoryMB:     32768,
+			MemoryMBUsed: memoryMBUsed,
+			LocalGB:      100,
+			LocalGBUsed:  40,
+		}
+		h.Service.Host = host
+		return h
+	}
+
+	hypervisors := []ComputeHypervisor{
+		hypervisor("compute1", "up", "enabled", 8, 16384),
+		hypervisor("compute2", "up", "enabled", 15, 0),
+		hypervisor("compute3", "down", "enabled", 0, 0),
+		hypervisor("compute4", "up", "disabled", 0, 0),
+		hypervisor("compute5", "up", "enabled", 0, 0),
+	}
+	groups := map[string][]string{
+		"az1": {"compute2", "compute1"},
+		"az2": {"compute3", "compute4"},
+		"az3": {"compute5"},
+	}
+	req := ComputeCapacityRequest{
+		VCPUs:               2,
+		RAM:                 4096,
+		CPUAllocationRatio:  1.0,
+		RAMAllocationRatio:  1.0,
+		DiskAllocationRatio: 1.0,
+	}
+
+	expected := []ComputeCapacity{
+		{Name: "az3", Hosts: []string{"compute5"}, Instances: 8, FreeVCPUs: 16, FreeRAM: 32768, FreeDisk: 60},
+		{Name: "az1", Hosts: []string{"compute1", "compute2"}, Instances: 4, FreeVCPUs: 9, FreeRAM: 49152, FreeDisk: 120},
+		{Name: "az2", Hosts: []string{"compute3", "compute4"}, Instances: 0, FreeVCPUs: 32, FreeRAM: 65536, FreeDisk: 120},
+	}
+
+	if actual := computeCapacityV2(hypervisors, groups, req); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestComputeHypervisorV2Instances(t *testing.T) {
+	h := ComputeHypervisor{
+		State:        "up",
+		Status:       "enabled",
+		VCPUs:        8,
+		VCPUsUsed:    6,
+		MemoryMB:     16384,
+		MemoryMBUsed: 4096,
+		LocalGB:      100,
+		LocalGBUsed:  80,
+	}
+
+	cases := []struct {
+		req       ComputeCapacityRequest
+		instances int
+	}{
+		{ComputeCapacityRequest{VCPUs: 1, RAM: 1024, CPUAllocationRatio: 1, RAMAllocationRatio: 1, DiskAllocationRatio: 1}, 2},
+		{ComputeCapacityRequest{VCPUs: 1, RAM: 1024, CPUAllocationRatio: 4, RAMAllocationRatio: 1, DiskAllocationRatio: 1}, 12},
+		{ComputeCapacityRequest{VCPUs: 1, RAM: 1024, Disk: 10, CPUAllocationRatio: 4, RAMAllocationRatio: 1, DiskAllocationRatio: 1}, 2},
+		{ComputeCapacityRequest{VCPUs: 4, RAM: 1024, CPUAllocationRatio: 1, RAMAllocationRatio: 1, DiskAllocationRatio: 1}, 0},
+	}
+
+	for _, c := range cases {
+		if instances, _, _, _ := computeHypervisorV2Instances(h, c.req); instances != c.instances {
+			t.Errorf("%+v: expected %d instances, got %d", c.req, c.instances, instances)
+		}
+	}
+}
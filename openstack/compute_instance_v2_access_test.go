@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestComputeInstanceV2AccessAddresses(t *testing.T) {
+	networks := []map[string]interface{}{
+		{
+			"name":        "management",
+			"fixed_ip_v4": "10.0.0.5",
+			"fixed_ip_v6": "fd00::5",
+		},
+		{
+			"name":        "public",
+			"fixed_ip_v4": "192.168.1.5",
+			"fixed_ip_v6": "fd01::5",
+			"floating_ip": "203.0.113.5",
+		},
+	}
+
+	cases := []struct {
+		name   string
+		raw    map[string]interface{}
+		hostv4 string
+		hostv6 string
+	}{
+		{
+			name:   "defaults",
+			raw:    map[string]interface{}{},
+			hostv4: "10.0.0.5",
+			hostv6: "fd00::5",
+		},
+		{
+			name:   "fixed preferred",
+			raw:    map[string]interface{}{"access_ip_preference": "fixed"},
+			hostv4: "10.0.0.5",
+			hostv6: "fd00::5",
+		},
+		{
+			name:   "floating preferred",
+			raw:    map[string]interface{}{"access_ip_preference": "floating"},
+			hostv4: "203.0.113.5",
+			hostv6: "fd00::5",
+		},
+		{
+			name:   "access network",
+			raw:    map[string]interface{}{"access_network_name": "public"},
+			hostv4: "203.0.113.5",
+			hostv6: "fd01::5",
+		},
+		{
+			name: "access network with fixed preferred",
+			raw: map[string]interface{}{
+				"access_network_name":  "public",
+				"access_ip_preference": "fixed",
+			},
+			hostv4: "192.168.1.5",
+			hostv6: "fd01::5",
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceComputeInstanceV2().Schema, tc.raw)
+
+		hostv4, hostv6 := getInstanceAccessAddresses(d, networks)
+		if hostv4 != tc.hostv4 || hostv6 != tc.hostv6 {
+			t.Errorf("%s: expected %s and %s, got %s and %s", tc.name, tc.hostv4, tc.hostv6, hostv4, hostv6)
+		}
+	}
+}
+
+func TestComputeInstanceV2AccessAddressesFloatingFallback(t *testing.T) {
+	networks := []map[string]interface{}{
+		{
+			"name":        "public",
+			"floating_ip": "203.0.113.5",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceComputeInstanceV2().Schema, map[string]interface{}{
+		"access_ip_preference": "fixed",
+	})
+
+	if hostv4, _ := getInstanceAccessAddresses(d, networks); hostv4 != "203.0.113.5" {
+		t.Errorf("expected the floating IP without a fixed IP, got %s", hostv4)
+	}
+}
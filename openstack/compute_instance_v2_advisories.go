@@ -0,0 +1,139 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
+)
+
+// computeInstanceV2Advisory checks the flavor extra specs and image
+// properties of an instance for a combination which is known to make Nova
+// fail to schedule or build it. It returns a description of the problem, or
+// an empty string if there is none.
+type computeInstanceV2Advisory func(flavorSpecs, imageProperties map[string]string) string
+
+// computeInstanceV2Advisories is the registry of advisories checked before an
+// instance is created. Advisories never prevent the creation: they're logged
+// as warnings and added to the error if the instance fails to build.
+var computeInstanceV2Advisories []computeInstanceV2Advisory
+
+func registerComputeInstanceV2Advisory(advisory computeInstanceV2Advisory) {
+	computeInstanceV2Advisories = append(computeInstanceV2Advisories, advisory)
+}
+
+func init() {
+	registerComputeInstanceV2Advisory(computeInstanceV2AdvisoryMemPageSize)
+	registerComputeInstanceV2Advisory(computeInstanceV2AdvisoryCPUPolicy)
+	registerComputeInstanceV2Advisory(computeInstanceV2AdvisoryCPUThreadPolicy)
+	registerComputeInstanceV2Advisory(computeInstanceV2AdvisoryNUMANodes)
+}
+
+// computeInstanceV2Advise runs all registered advisories against the flavor
+// and image of an instance. Any error while retrieving them is only logged,
+// since the advisories are merely a debugging aid.
+func computeInstanceV2Advise(computeClient *gophercloud.ServiceClient, imageID, flavorID string) []string {
+	flavorSpecs, err := computeFlavorV2ExtraSpecs(computeClient, flavorID)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve extra specs of flavor %s, skipping advisories: %s", flavorID, err)
+		return nil
+	}
+
+	imageProperties := make(map[string]string)
+	if imageID != "" {
+		image, err := images.Get(computeClient, imageID).Extract()
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve image %s, skipping advisories: %s", imageID, err)
+			return nil
+		}
+		for k, v := range image.Metadata {
+			imageProperties[k] = fmt.Sprint(v)
+		}
+	}
+
+	var advisories []string
+	for _, advisory := range computeInstanceV2Advisories {
+		if msg := advisory(flavorSpecs, imageProperties); msg != "" {
+			log.Printf("[WARN] Instance may fail to build: %s", msg)
+			advisories = append(advisories, msg)
+		}
+	}
+
+	return advisories
+}
+
+// computeInstanceV2AdvisoryError adds the advisories to an error returned
+// while creating an instance.
+func computeInstanceV2AdvisoryError(err error, advisories []string) error {
+	if len(advisories) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%s\n\nThe flavor and image of the instance use settings "+
+		"which are known to conflict:\n\n* %s", err, strings.Join(advisories, "\n* "))
+}
+
+func computeInstanceV2AdvisoryMemPageSize(flavorSpecs, imageProperties map[string]string) string {
+	flavorSize, imageSize := flavorSpecs["hw:mem_page_size"], imageProperties["hw_mem_page_size"]
+	if imageSize == "" {
+		return ""
+	}
+
+	switch flavorSize {
+	case "":
+		return fmt.Sprintf("the image requests hw_mem_page_size %q, but the flavor "+
+			"does not set hw:mem_page_size to allow it", imageSize)
+	case "large", "any":
+		return ""
+	}
+
+	if flavorSize != imageSize {
+		return fmt.Sprintf("the image requests hw_mem_page_size %q, but the flavor "+
+			"requires hw:mem_page_size %q", imageSize, flavorSize)
+	}
+
+	return ""
+}
+
+func computeInstanceV2AdvisoryCPUPolicy(flavorSpecs, imageProperties map[string]string) string {
+	flavorPolicy, imagePolicy := flavorSpecs["hw:cpu_policy"], imageProperties["hw_cpu_policy"]
+	if flavorPolicy == "shared" && imagePolicy == "dedicated" {
+		return "the image requests hw_cpu_policy \"dedicated\", but the flavor " +
+			"forbids pinning with hw:cpu_policy \"shared\""
+	}
+
+	return ""
+}
+
+func computeInstanceV2AdvisoryCPUThreadPolicy(flavorSpecs, imageProperties map[string]string) string {
+	threadPolicy := flavorSpecs["hw:cpu_thread_policy"]
+	if threadPolicy == "" {
+		threadPolicy = imageProperties["hw_cpu_thread_policy"]
+	}
+	if threadPolicy == "" {
+		return ""
+	}
+
+	cpuPolicy := flavorSpecs["hw:cpu_policy"]
+	if cpuPolicy == "" {
+		cpuPolicy = imageProperties["hw_cpu_policy"]
+	}
+	if cpuPolicy != "dedicated" {
+		return fmt.Sprintf("a cpu_thread_policy of %q is set, which requires a "+
+			"cpu_policy of \"dedicated\"", threadPolicy)
+	}
+
+	return ""
+}
+
+func computeInstanceV2AdvisoryNUMANodes(flavorSpecs, imageProperties map[string]string) string {
+	flavorNodes, imageNodes := flavorSpecs["hw:numa_nodes"], imageProperties["hw_numa_nodes"]
+	if flavorNodes != "" && imageNodes != "" && flavorNodes != imageNodes {
+		return fmt.Sprintf("the image requests hw_numa_nodes %q, but the flavor "+
+			"sets hw:numa_nodes %q", imageNodes, flavorNodes)
+	}
+
+	return ""
+}
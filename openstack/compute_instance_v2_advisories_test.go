@@ -0,0 +1,88 @@
+package openstack
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestComputeInstanceV2Advisories(t *testing.T) {
+	cases := []struct {
+		name            string
+		flavorSpecs     map[string]string
+		imageProperties map[string]string
+		advisories      int
+	}{
+		{
+			name:        "no settings",
+			flavorSpecs: map[string]string{},
+		},
+		{
+			name:            "image page size without flavor page size",
+			flavorSpecs:     map[string]string{},
+			imageProperties: map[string]string{"hw_mem_page_size": "1GB"},
+			advisories:      1,
+		},
+		{
+			name:            "image page size allowed by flavor",
+			flavorSpecs:     map[string]string{"hw:mem_page_size": "large"},
+			imageProperties: map[string]string{"hw_mem_page_size": "1GB"},
+		},
+		{
+			name:            "conflicting page sizes",
+			flavorSpecs:     map[string]string{"hw:mem_page_size": "2MB"},
+			imageProperties: map[string]string{"hw_mem_page_size": "1GB"},
+			advisories:      1,
+		},
+		{
+			name:            "dedicated image on shared flavor",
+			flavorSpecs:     map[string]string{"hw:cpu_policy": "shared"},
+			imageProperties: map[string]string{"hw_cpu_policy": "dedicated"},
+			advisories:      1,
+		},
+		{
+			name:        "thread policy without pinning",
+			flavorSpecs: map[string]string{"hw:cpu_thread_policy": "isolate"},
+			advisories:  1,
+		},
+		{
+			name: "thread policy with pinning",
+			flavorSpecs: map[string]string{
+				"hw:cpu_policy":        "dedicated",
+				"hw:cpu_thread_policy": "isolate",
+			},
+		},
+		{
+			name:            "conflicting NUMA nodes",
+			flavorSpecs:     map[string]string{"hw:numa_nodes": "2", "hw:mem_page_size": "2MB"},
+			imageProperties: map[string]string{"hw_numa_nodes": "1", "hw_mem_page_size": "1GB"},
+			advisories:      2,
+		},
+	}
+
+	for _, c := range cases {
+		var advisories []string
+		for _, advisory := range computeInstanceV2Advisories {
+			if msg := advisory(c.flavorSpecs, c.imageProperties); msg != "" {
+				advisories = append(advisories, msg)
+			}
+		}
+
+		if len(advisories) != c.advisories {
+			t.Errorf("%s: expected %d advisories, got %d: %v", c.name, c.advisories, len(advisories), advisories)
+		}
+	}
+}
+
+func TestComputeInstanceV2AdvisoryError(t *testing.T) {
+	err := errors.New("No valid host was found")
+
+	if computeInstanceV2AdvisoryError(err, nil) != err {
+		t.Errorf("expected the error to be unchanged without advisories")
+	}
+
+	msg := computeInstanceV2AdvisoryError(err, []string{"first", "second"}).Error()
+	if !strings.HasPrefix(msg, err.Error()) || !strings.Contains(msg, "* first\n* second") {
+		t.Errorf("unexpected error message: %s", msg)
+	}
+}
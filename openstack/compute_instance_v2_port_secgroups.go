@@ -0,0 +1,236 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Nova only applies security group changes to the ports it created for an
+// instance, so the security groups of the ports given in its network blocks
+// are managed here when manage_port_security_groups is set.
+
+// getInstancePortIDs returns the IDs of the ports given by port or port_name
+// in the network blocks of an instance.
+func getInstancePortIDs(config *Config, d *schema.ResourceData) ([]string, error) {
+	var networkDetails []map[string]interface{}
+	for _, raw := range d.Get("network").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+
+		rawMap := raw.(map[string]interface{})
+		networkDetails = append(networkDetails, map[string]interface{}{
+			"uuid":      rawMap["uuid"].(string),
+			"port":      rawMap["port"].(string),
+			"port_name": rawMap["port_name"].(string),
+		})
+	}
+
+	if err := resolveInstancePortNames(config, GetRegion(d), networkDetails); err != nil {
+		return nil, err
+	}
+
+	var portIDs []string
+	for _, net := range networkDetails {
+		if portID := net["port"].(string); portID != "" {
+			portIDs = append(portIDs, portID)
+		}
+	}
+
+	return portIDs, nil
+}
+
+// resourceInstanceHasPortsV2 tells whether any network block of an instance
+// gives a port.
+func resourceInstanceHasPortsV2(d *schema.ResourceData) bool {
+	for _, raw := range d.Get("network").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+
+		rawMap := raw.(map[string]interface{})
+		if rawMap["port"].(string) != "" || rawMap["port_name"].(string) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setInstancePortSecGroups sets the security groups of the ports of an
+// instance to its security_groups.
+func setInstancePortSecGroups(config *Config, d *schema.ResourceData) error {
+	portIDs, err := getInstancePortIDs(config, d)
+	if err != nil || len(portIDs) == 0 {
+		return err
+	}
+
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	names := resourceInstanceSecGroupsV2(d)
+	for _, portID := range portIDs {
+		port, err := ports.Get(networkingClient, portID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving port %s of OpenStack instance %s: %s", portID, d.Id(), err)
+		}
+
+		secGroupIDs, err := getSecGroupIDsFromNames(networkingClient, port.TenantID, names)
+		if err != nil {
+			return err
+		}
+
+		updateOpts := PortSecurityGroupsUpdateOpts{
+			SecurityGroups: secGroupIDs,
+		}
+
+		log.Printf("[DEBUG] Setting security groups of port %s of instance %s: %#v", portID, d.Id(), updateOpts)
+		if _, err := ports.Update(networkingClient, portID, updateOpts).Extract(); err != nil {
+			return fmt.Errorf("Error setting security groups of port %s of OpenStack instance %s: %s", portID, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// readInstancePortSecGroups sets security_groups to the security groups of
+// the first port of an instance which doesn't have the expected ones, so the
+// drift shows up in the plan. The ports and their security groups are each
+// retrieved with a single request.
+func readInstancePortSecGroups(config *Config, d *schema.ResourceData, expected []string) error {
+	portIDs, err := getInstancePortIDs(config, d)
+	if err != nil || len(portIDs) == 0 {
+		return err
+	}
+
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	allPages, err := ports.List(networkingClient, ports.ListOpts{DeviceID: d.Id()}).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list ports of OpenStack instance %s: %s", d.Id(), err)
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve ports of OpenStack instance %s: %s", d.Id(), err)
+	}
+
+	instancePorts := make(map[string]ports.Port, len(allPorts))
+	var secGroupIDs []string
+	for _, port := range allPorts {
+		instancePorts[port.ID] = port
+		secGroupIDs = append(secGroupIDs, port.SecurityGroups...)
+	}
+
+	names, err := getSecGroupNamesFromIDs(networkingClient, secGroupIDs)
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(expected)
+
+	for _, portID := range portIDs {
+		port, ok := instancePorts[portID]
+		if !ok {
+			continue
+		}
+
+		var actual []string
+		for _, id := range port.SecurityGroups {
+			actual = append(actual, names[id])
+		}
+		sort.Strings(actual)
+
+		if !strSliceEqual(actual, expected) {
+			log.Printf("[DEBUG] Port %s of instance %s has security groups %v instead of %v", portID, d.Id(), actual, expected)
+			d.Set("security_groups", actual)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// getSecGroupNamesFromIDs returns the names of the security groups with the
+// given IDs, keyed by ID. Neutron accepts several IDs in one request, which
+// the vendored groups.ListOpts doesn't support.
+func getSecGroupNamesFromIDs(networkingClient *gophercloud.ServiceClient, ids []string) (map[string]string, error) {
+	names := make(map[string]string)
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	q := url.Values{}
+	for _, id := range ids {
+		if _, ok := names[id]; !ok {
+			q.Add("id", id)
+			names[id] = ""
+		}
+	}
+
+	u := networkingClient.ServiceURL("security-groups") + "?" + q.Encode()
+	allPages, err := pagination.NewPager(networkingClient, u, func(r pagination.PageResult) pagination.Page {
+		return groups.SecGroupPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list security groups %v: %s", ids, err)
+	}
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve security groups %v: %s", ids, err)
+	}
+
+	for _, secGroup := range allGroups {
+		names[secGroup.ID] = secGroup.Name
+	}
+
+	return names, nil
+}
+
+// getSecGroupIDsFromNames returns the IDs of the security groups with the
+// given names in a project.
+func getSecGroupIDsFromNames(networkingClient *gophercloud.ServiceClient, tenantID string, names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		listOpts := groups.ListOpts{
+			Name:     name,
+			TenantID: tenantID,
+		}
+
+		allPages, err := groups.List(networkingClient, listOpts).AllPages()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to list security groups named %s: %s", name, err)
+		}
+
+		allGroups, err := groups.ExtractGroups(allPages)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to retrieve security groups named %s: %s", name, err)
+		}
+
+		if len(allGroups) < 1 {
+			return nil, fmt.Errorf("No security group named %s was found.", name)
+		}
+
+		if len(allGroups) > 1 {
+			return nil, fmt.Errorf("More than one security group named %s was found.", name)
+		}
+
+		ids = append(ids, allGroups[0].ID)
+	}
+
+	return ids, nil
+}
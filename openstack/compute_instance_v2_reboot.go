@@ -0,0 +1,99 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Some images only read their configuration, such as metadata keys consumed
+// by a boot script, when they start. reboot_on_change reboots an instance
+// once the other changes of an update are applied, when one of these keys
+// changed.
+
+func resourceInstanceRebootOnChangeSchemaV2() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metadata_keys": &schema.Schema{
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+				"type": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "SOFT",
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						value := v.(string)
+						if value != string(servers.SoftReboot) && value != string(servers.HardReboot) {
+							errors = append(errors, fmt.Errorf("Only 'SOFT' and 'HARD' are supported values for %q", k))
+						}
+						return
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceInstanceRebootOnChangeV2 returns how to reboot an instance for the
+// changes of an update, and whether it has to be rebooted at all.
+func resourceInstanceRebootOnChangeV2(d *schema.ResourceData) (servers.RebootMethod, bool) {
+	rebootRaw := d.Get("reboot_on_change").([]interface{})
+	if len(rebootRaw) == 0 || rebootRaw[0] == nil || !d.HasChange("metadata") {
+		return "", false
+	}
+
+	reboot := rebootRaw[0].(map[string]interface{})
+
+	var keys []string
+	for _, key := range reboot["metadata_keys"].(*schema.Set).List() {
+		keys = append(keys, key.(string))
+	}
+
+	oldMetadata, newMetadata := d.GetChange("metadata")
+	if !instanceMetadataKeysChanged(oldMetadata.(map[string]interface{}), newMetadata.(map[string]interface{}), keys) {
+		return "", false
+	}
+
+	return servers.RebootMethod(reboot["type"].(string)), true
+}
+
+// instanceMetadataKeysChanged tells whether any of the keys was added,
+// removed or changed between two versions of the metadata of an instance.
+func instanceMetadataKeysChanged(oldMetadata, newMetadata map[string]interface{}, keys []string) bool {
+	for _, key := range keys {
+		oldValue, oldOk := oldMetadata[key]
+		newValue, newOk := newMetadata[key]
+		if oldOk != newOk || oldValue != newValue {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rebootInstance reboots an instance and waits for it to be active again.
+func rebootInstance(client *gophercloud.ServiceClient, instanceID string, method servers.RebootMethod, timeout time.Duration) error {
+	log.Printf("[DEBUG] Rebooting instance (%s) with method %s", instanceID, method)
+
+	rebootOpts := &servers.RebootOpts{
+		Type: method,
+	}
+	if err := servers.Reboot(client, instanceID, rebootOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("Error rebooting OpenStack server (%s): %s", instanceID, err)
+	}
+
+	// Nova sets the status to REBOOT or HARD_REBOOT before accepting the
+	// request, so the instance isn't seen as active before it rebooted.
+	return waitForServerV2Status(client, instanceID, []string{"REBOOT", "HARD_REBOOT"}, "ACTIVE", timeout)
+}
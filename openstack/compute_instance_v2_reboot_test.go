@@ -0,0 +1,51 @@
+package openstack
+
+import (
+	"testing"
+)
+
+func TestInstanceMetadataKeysChanged(t *testing.T) {
+	cases := []struct {
+		name        string
+		oldMetadata map[string]interface{}
+		newMetadata map[string]interface{}
+		changed     bool
+	}{
+		{
+			name:        "unchanged",
+			oldMetadata: map[string]interface{}{"role": "web", "owner": "a"},
+			newMetadata: map[string]interface{}{"role": "web", "owner": "b"},
+		},
+		{
+			name:        "changed",
+			oldMetadata: map[string]interface{}{"role": "web"},
+			newMetadata: map[string]interface{}{"role": "db"},
+			changed:     true,
+		},
+		{
+			name:        "added",
+			oldMetadata: map[string]interface{}{},
+			newMetadata: map[string]interface{}{"role": "web"},
+			changed:     true,
+		},
+		{
+			name:        "removed",
+			oldMetadata: map[string]interface{}{"role": "web"},
+			newMetadata: map[string]interface{}{},
+			changed:     true,
+		},
+		{
+			name:        "set to an empty value",
+			oldMetadata: map[string]interface{}{},
+			newMetadata: map[string]interface{}{"role": ""},
+			changed:     true,
+		},
+	}
+
+	for _, c := range cases {
+		changed := instanceMetadataKeysChanged(c.oldMetadata, c.newMetadata, []string{"role"})
+		if changed != c.changed {
+			t.Errorf("%s: expected changed to be %t, got %t", c.name, c.changed, changed)
+		}
+	}
+}
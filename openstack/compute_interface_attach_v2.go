@@ -0,0 +1,64 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ComputeInterfaceAttachment is a network interface attached to an instance.
+type ComputeInterfaceAttachment struct {
+	PortID    string                              `json:"port_id"`
+	NetID     string                              `json:"net_id"`
+	MACAddr   string                              `json:"mac_addr"`
+	PortState string                              `json:"port_state"`
+	FixedIPs  []ComputeInterfaceAttachmentFixedIP `json:"fixed_ips"`
+}
+
+type ComputeInterfaceAttachmentFixedIP struct {
+	SubnetID  string `json:"subnet_id,omitempty"`
+	IPAddress string `json:"ip_address"`
+}
+
+// ComputeInterfaceAttachmentCreateOpts represents the attributes used when
+// attaching an interface. Only one of PortID and NetID must be set.
+type ComputeInterfaceAttachmentCreateOpts struct {
+	PortID   string                              `json:"port_id,omitempty"`
+	NetID    string                              `json:"net_id,omitempty"`
+	FixedIPs []ComputeInterfaceAttachmentFixedIP `json:"fixed_ips,omitempty"`
+	Tag      string                              `json:"tag,omitempty"`
+}
+
+func computeInterfaceAttachV2Create(client *gophercloud.ServiceClient, instanceID string, opts ComputeInterfaceAttachmentCreateOpts) (*ComputeInterfaceAttachment, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "interfaceAttachment")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		InterfaceAttachment ComputeInterfaceAttachment `json:"interfaceAttachment"`
+	}
+	_, err = client.Post(client.ServiceURL("servers", instanceID, "os-interface"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.InterfaceAttachment, nil
+}
+
+func computeInterfaceAttachV2Get(client *gophercloud.ServiceClient, instanceID, portID string) (*ComputeInterfaceAttachment, error) {
+	var res struct {
+		InterfaceAttachment ComputeInterfaceAttachment `json:"interfaceAttachment"`
+	}
+	_, err := client.Get(client.ServiceURL("servers", instanceID, "os-interface", portID), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.InterfaceAttachment, nil
+}
+
+func computeInterfaceAttachV2Delete(client *gophercloud.ServiceClient, instanceID, portID string) error {
+	_, err := client.Delete(client.ServiceURL("servers", instanceID, "os-interface", portID), nil)
+	return err
+}
@@ -0,0 +1,70 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ComputeAbsoluteLimits are the absolute compute limits of a project. A
+// maximum of -1 means unlimited.
+type ComputeAbsoluteLimits struct {
+	MaxTotalCores      int `json:"maxTotalCores"`
+	MaxTotalInstances  int `json:"maxTotalInstances"`
+	MaxTotalRAMSize    int `json:"maxTotalRAMSize"`
+	TotalCoresUsed     int `json:"totalCoresUsed"`
+	TotalInstancesUsed int `json:"totalInstancesUsed"`
+	TotalRAMUsed       int `json:"totalRAMUsed"`
+}
+
+func computeLimitsV2Get(client *gophercloud.ServiceClient) (*ComputeAbsoluteLimits, error) {
+	var res struct {
+		Limits struct {
+			Absolute ComputeAbsoluteLimits `json:"absolute"`
+		} `json:"limits"`
+	}
+	_, err := client.Get(client.ServiceURL("limits"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Limits.Absolute, nil
+}
+
+// computeLimitsV2Remaining returns how much of a limit is left, or -1 if it's
+// unlimited.
+func computeLimitsV2Remaining(max, used int) int {
+	if max < 0 {
+		return -1
+	}
+	if used > max {
+		return 0
+	}
+
+	return max - used
+}
+
+// computeLimitsV2Check returns an error listing every requested amount which
+// exceeds what is left of the limits.
+func computeLimitsV2Check(limits *ComputeAbsoluteLimits, instances, vcpus, ram int) error {
+	var exceeded []string
+	check := func(name string, requested, max, used int) {
+		remaining := computeLimitsV2Remaining(max, used)
+		if remaining >= 0 && requested > remaining {
+			exceeded = append(exceeded, fmt.Sprintf("%s: requested %d, remaining %d (used %d of %d)",
+				name, requested, remaining, used, max))
+		}
+	}
+
+	check("instances", instances, limits.MaxTotalInstances, limits.TotalInstancesUsed)
+	check("vcpus", vcpus, limits.MaxTotalCores, limits.TotalCoresUsed)
+	check("ram", ram, limits.MaxTotalRAMSize, limits.TotalRAMUsed)
+
+	if len(exceeded) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("The requested resources exceed the remaining compute quota:\n\n* %s",
+		strings.Join(exceeded, "\n* "))
+}
@@ -0,0 +1,49 @@
+package openstack
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestComputeLimitsV2Check(t *testing.T) {
+	limits := &ComputeAbsoluteLimits{
+		MaxTotalCores:      20,
+		MaxTotalInstances:  10,
+		MaxTotalRAMSize:    -1,
+		TotalCoresUsed:     16,
+		TotalInstancesUsed: 4,
+		TotalRAMUsed:       65536,
+	}
+
+	if err := computeLimitsV2Check(limits, 2, 4, 1048576); err != nil {
+		t.Fatalf("expected the request to fit in the quota: %s", err)
+	}
+
+	err := computeLimitsV2Check(limits, 7, 6, 0)
+	if err == nil {
+		t.Fatalf("expected the request to exceed the quota")
+	}
+
+	msg := err.Error()
+	if !strings.Contains(msg, "instances: requested 7, remaining 6 (used 4 of 10)") ||
+		!strings.Contains(msg, "vcpus: requested 6, remaining 4 (used 16 of 20)") ||
+		strings.Contains(msg, "ram") {
+		t.Fatalf("unexpected error message: %s", msg)
+	}
+}
+
+func TestComputeLimitsV2Remaining(t *testing.T) {
+	cases := []struct {
+		max, used, remaining int
+	}{
+		{10, 4, 6},
+		{10, 12, 0},
+		{-1, 4, -1},
+	}
+
+	for _, c := range cases {
+		if remaining := computeLimitsV2Remaining(c.max, c.used); remaining != c.remaining {
+			t.Errorf("max %d, used %d: expected %d remaining, got %d", c.max, c.used, c.remaining, remaining)
+		}
+	}
+}
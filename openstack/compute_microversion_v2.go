@@ -0,0 +1,70 @@
+package openstack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// computeV2MaxMicroversion returns the highest microversion supported by the
+// Compute API of the client, from its version document.
+func computeV2MaxMicroversion(client *gophercloud.ServiceClient) (string, error) {
+	var res struct {
+		Version struct {
+			Version string `json:"version"`
+		} `json:"version"`
+	}
+	_, err := client.Get(client.ServiceURL(), &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if res.Version.Version == "" {
+		return "", fmt.Errorf("The Compute API does not support microversions")
+	}
+
+	return res.Version.Version, nil
+}
+
+// computeV2MicroversionAtLeast returns whether a microversion such as "2.42"
+// is the same as or newer than min. Invalid microversions are never newer.
+func computeV2MicroversionAtLeast(microversion, min string) bool {
+	major, minor, err := computeV2ParseMicroversion(microversion)
+	if err != nil {
+		return false
+	}
+
+	minMajor, minMinor, err := computeV2ParseMicroversion(min)
+	if err != nil {
+		return false
+	}
+
+	if major != minMajor {
+		return major > minMajor
+	}
+
+	return minor >= minMinor
+}
+
+func computeV2ParseMicroversion(microversion string) (int, int, error) {
+	parts := strings.Split(microversion, ".")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("Invalid microversion: %s", microversion)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid microversion: %s", microversion)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid microversion: %s", microversion)
+	}
+
+	return major, minor, nil
+}
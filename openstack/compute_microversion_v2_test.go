@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+)
+
+func TestComputeV2MicroversionAtLeast(t *testing.T) {
+	cases := []struct {
+		microversion string
+		min          string
+		expected     bool
+	}{
+		{"2.42", "2.42", true},
+		{"2.60", "2.42", true},
+		{"2.9", "2.32", false},
+		{"2.100", "2.42", true},
+		{"3.1", "2.42", true},
+		{"2.36", "2.42", false},
+		{"", "2.32", false},
+		{"latest", "2.32", false},
+	}
+
+	for _, c := range cases {
+		if actual := computeV2MicroversionAtLeast(c.microversion, c.min); actual != c.expected {
+			t.Errorf("%s >= %s: expected %t, got %t", c.microversion, c.min, c.expected, actual)
+		}
+	}
+}
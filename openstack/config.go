@@ -4,8 +4,12 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
@@ -31,7 +35,64 @@ type Config struct {
 	Username         string
 	UserID           string
 
+	// DefaultMetadata and DefaultTags are merged into the metadata and
+	// tags of the resources which support them.
+	DefaultMetadata map[string]string
+	DefaultTags     []string
+
+	// NeutronRevisionCheck makes updates of Neutron resources conditional
+	// on their revision_number.
+	NeutronRevisionCheck bool
+
+	// ExternalNetworkTagPriority and ExternalNetworkPreferDefault rank the
+	// external networks when one has to be chosen among several.
+	ExternalNetworkTagPriority   []string
+	ExternalNetworkPreferDefault bool
+
 	osClient *gophercloud.ProviderClient
+
+	// serviceClients is shared by the copies of the Config made for each
+	// operation, so that resources refreshed in parallel don't set up the
+	// same service clients over and over.
+	serviceClients *serviceClientCache
+
+	// projectClients is shared like serviceClients and holds the
+	// authentication of the resources created in another project.
+	projectClients *projectClientCache
+
+	// apiErrors records the failed requests of the copy of the Config made
+	// for an operation.
+	apiErrors *apiErrorRecorder
+}
+
+// serviceClientCache holds the service clients by service type and region.
+type serviceClientCache struct {
+	mu      sync.Mutex
+	entries map[string]*serviceClientEntry
+}
+
+type serviceClientEntry struct {
+	once   sync.Once
+	client *gophercloud.ServiceClient
+	err    error
+}
+
+// projectClientCache holds the provider clients scoped to other projects
+// and their service clients, by project ID.
+type projectClientCache struct {
+	mu      sync.Mutex
+	entries map[string]*projectClientEntry
+
+	// tokenProjectOnce looks up the project of the provider's own token.
+	tokenProjectOnce sync.Once
+	tokenProjectID   string
+}
+
+type projectClientEntry struct {
+	once           sync.Once
+	client         *gophercloud.ProviderClient
+	serviceClients *serviceClientCache
+	err            error
 }
 
 func (c *Config) loadAndValidate() error {
@@ -53,17 +114,7 @@ func (c *Config) loadAndValidate() error {
 		return fmt.Errorf("Invalid endpoint type provided")
 	}
 
-	ao := gophercloud.AuthOptions{
-		DomainID:         c.DomainID,
-		DomainName:       c.DomainName,
-		IdentityEndpoint: c.IdentityEndpoint,
-		Password:         c.Password,
-		TenantID:         c.TenantID,
-		TenantName:       c.TenantName,
-		TokenID:          c.Token,
-		Username:         c.Username,
-		UserID:           c.UserID,
-	}
+	ao := c.authOptions()
 
 	client, err := openstack.NewClient(ao.IdentityEndpoint)
 	if err != nil {
@@ -114,7 +165,21 @@ func (c *Config) loadAndValidate() error {
 		osDebug = true
 	}
 
-	transport := &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config}
+	// All service clients share this transport. Keep enough idle
+	// connections per host for parallel operations to reuse them instead of
+	// doing a TLS handshake for most requests.
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:     config,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 100,
+		IdleConnTimeout:     90 * time.Second,
+	}
 	client.HTTPClient = http.Client{
 		Transport: &LogRoundTripper{
 			Rt:      transport,
@@ -131,54 +196,235 @@ func (c *Config) loadAndValidate() error {
 	}
 
 	c.osClient = client
+	c.serviceClients = &serviceClientCache{
+		entries: make(map[string]*serviceClientEntry),
+	}
+	c.projectClients = &projectClientCache{
+		entries: make(map[string]*projectClientEntry),
+	}
 
 	return nil
 }
 
+func (c *Config) authOptions() gophercloud.AuthOptions {
+	return gophercloud.AuthOptions{
+		DomainID:         c.DomainID,
+		DomainName:       c.DomainName,
+		IdentityEndpoint: c.IdentityEndpoint,
+		Password:         c.Password,
+		TenantID:         c.TenantID,
+		TenantName:       c.TenantName,
+		TokenID:          c.Token,
+		Username:         c.Username,
+		UserID:           c.UserID,
+	}
+}
+
+// projectConfig returns a copy of the Config whose clients are scoped to
+// another project, so that resources can be managed on behalf of it without
+// a provider alias. The credentials of the provider need a role in the
+// project. An empty projectID, or the project the provider is already
+// scoped to, returns the Config itself.
+func (c *Config) projectConfig(projectID, region string) (*Config, error) {
+	if projectID == "" || c.projectClients == nil || projectID == c.tokenProjectID(region) {
+		return c, nil
+	}
+
+	if c.Swauth {
+		return nil, fmt.Errorf("Resources can't be managed in another project with Swift authentication")
+	}
+
+	cache := c.projectClients
+
+	cache.mu.Lock()
+	entry, ok := cache.entries[projectID]
+	if !ok {
+		entry = &projectClientEntry{}
+		cache.entries[projectID] = entry
+	}
+	cache.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.client, entry.err = c.newProjectClient(projectID)
+		entry.serviceClients = &serviceClientCache{
+			entries: make(map[string]*serviceClientEntry),
+		}
+	})
+
+	if entry.err != nil {
+		cache.mu.Lock()
+		if cache.entries[projectID] == entry {
+			delete(cache.entries, projectID)
+		}
+		cache.mu.Unlock()
+
+		return nil, fmt.Errorf("Error authenticating to OpenStack project %s: %s", projectID, entry.err)
+	}
+
+	config := *c
+	config.osClient = entry.client
+	config.serviceClients = entry.serviceClients
+	if c.apiErrors != nil {
+		config.osClient = recordedProviderClient(entry.client, c.apiErrors)
+	}
+
+	return &config, nil
+}
+
+// tokenProjectID returns the project the provider is scoped to, or an empty
+// string if it can't be found, e.g. with Swift authentication.
+func (c *Config) tokenProjectID(region string) string {
+	if c.TenantID != "" {
+		return c.TenantID
+	}
+
+	cache := c.projectClients
+	cache.tokenProjectOnce.Do(func() {
+		cache.tokenProjectID = identityV3TokenProjectID(c, region)
+	})
+
+	return cache.tokenProjectID
+}
+
+// newProjectClient authenticates with the credentials of the provider,
+// scoped to a project.
+func (c *Config) newProjectClient(projectID string) (*gophercloud.ProviderClient, error) {
+	ao := c.authOptions()
+	ao.TenantID = projectID
+	ao.TenantName = ""
+
+	client, err := openstack.NewClient(ao.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Share the transport of the provider client, without the recorder of
+	// the operation which happens to authenticate first.
+	transport := c.osClient.HTTPClient.Transport
+	if rt, ok := transport.(*APIErrorRoundTripper); ok {
+		transport = rt.Rt
+	}
+
+	client.UserAgent = c.osClient.UserAgent
+	client.HTTPClient = http.Client{Transport: transport}
+
+	if err := openstack.Authenticate(client, ao); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// baremetalV1Client returns a client for the Bare Metal (Ironic) API.
+func (c *Config) baremetalV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.versionedServiceClient(region, "baremetal", "v1")
+}
+
 func (c *Config) blockStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewBlockStorageV1(c.osClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
+	return c.cachedServiceClient("volume", region, func() (*gophercloud.ServiceClient, error) {
+		return openstack.NewBlockStorageV1(c.osClient, gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		})
 	})
 }
 
 func (c *Config) blockStorageV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewBlockStorageV2(c.osClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
+	return c.cachedServiceClient("volumev2", region, func() (*gophercloud.ServiceClient, error) {
+		return openstack.NewBlockStorageV2(c.osClient, gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		})
+	})
+}
+
+// blockStorageV3Client returns a client for the Block Storage (Cinder) v3
+// API.
+func (c *Config) blockStorageV3Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.cachedServiceClient("volumev3", region, func() (*gophercloud.ServiceClient, error) {
+		eo := gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		}
+		eo.ApplyDefaults("volumev3")
+		url, err := c.osClient.EndpointLocator(eo)
+		if err != nil {
+			return nil, err
+		}
+
+		return &gophercloud.ServiceClient{ProviderClient: c.osClient, Endpoint: url}, nil
 	})
 }
 
 func (c *Config) computeV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewComputeV2(c.osClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
+	return c.cachedServiceClient("compute", region, func() (*gophercloud.ServiceClient, error) {
+		return openstack.NewComputeV2(c.osClient, gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		})
+	})
+}
+
+// containerInfraV1Client returns a client for the Container Infrastructure
+// Management (Magnum) API.
+func (c *Config) containerInfraV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.cachedServiceClient("container-infra", region, func() (*gophercloud.ServiceClient, error) {
+		eo := gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		}
+		eo.ApplyDefaults("container-infra")
+		url, err := c.osClient.EndpointLocator(eo)
+		if err != nil {
+			return nil, err
+		}
+
+		return &gophercloud.ServiceClient{ProviderClient: c.osClient, Endpoint: url}, nil
 	})
 }
 
 func (c *Config) dnsV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewDNSV2(c.osClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
+	return c.cachedServiceClient("dns", region, func() (*gophercloud.ServiceClient, error) {
+		return openstack.NewDNSV2(c.osClient, gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		})
 	})
 }
 
+// identityV3Client returns a client for the Identity (Keystone) v3 API. The
+// vendored gophercloud only includes its token API.
+func (c *Config) identityV3Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.versionedServiceClient(region, "identity", "v3")
+}
+
 func (c *Config) imageV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewImageServiceV2(c.osClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
+	return c.cachedServiceClient("image", region, func() (*gophercloud.ServiceClient, error) {
+		return openstack.NewImageServiceV2(c.osClient, gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		})
 	})
 }
 
+// infraOptimV1Client returns a client for the Infrastructure Optimization
+// (Watcher) API.
+func (c *Config) infraOptimV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return c.versionedServiceClient(region, "infra-optim", "v1")
+}
+
 func (c *Config) networkingV2Client(region string) (*gophercloud.ServiceClient, error) {
-	return openstack.NewNetworkV2(c.osClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
+	return c.cachedServiceClient("network", region, func() (*gophercloud.ServiceClient, error) {
+		return openstack.NewNetworkV2(c.osClient, gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		})
 	})
 }
 
 func (c *Config) objectStorageV1Client(region string) (*gophercloud.ServiceClient, error) {
-	// If Swift Authentication is being used, return a swauth client.
+	// If Swift Authentication is being used, return a swauth client. It
+	// isn't cached, since it sets the token of the provider client.
 	if c.Swauth {
 		return swauth.NewObjectStorageV1(c.osClient, swauth.AuthOpts{
 			User: c.Username,
@@ -186,10 +432,83 @@ func (c *Config) objectStorageV1Client(region string) (*gophercloud.ServiceClien
 		})
 	}
 
-	return openstack.NewObjectStorageV1(c.osClient, gophercloud.EndpointOpts{
-		Region:       region,
-		Availability: c.getEndpointType(),
+	return c.cachedServiceClient("object-store", region, func() (*gophercloud.ServiceClient, error) {
+		return openstack.NewObjectStorageV1(c.osClient, gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		})
+	})
+}
+
+// versionedServiceClient returns a client for a service whose catalog
+// endpoint may or may not include the API version.
+func (c *Config) versionedServiceClient(region, serviceType, version string) (*gophercloud.ServiceClient, error) {
+	return c.cachedServiceClient(serviceType, region, func() (*gophercloud.ServiceClient, error) {
+		eo := gophercloud.EndpointOpts{
+			Region:       region,
+			Availability: c.getEndpointType(),
+		}
+		eo.ApplyDefaults(serviceType)
+		url, err := c.osClient.EndpointLocator(eo)
+		if err != nil {
+			return nil, err
+		}
+
+		client := &gophercloud.ServiceClient{ProviderClient: c.osClient, Endpoint: url}
+		if !strings.HasSuffix(url, "/"+version+"/") {
+			client.ResourceBase = url + version + "/"
+		}
+
+		return client, nil
+	})
+}
+
+// cachedServiceClient returns a copy of the client of a service in a region,
+// which newClient creates on the first call. The copy uses the provider
+// client of this Config and can be changed, e.g. to set a microversion,
+// without affecting other callers. Failures aren't cached.
+func (c *Config) cachedServiceClient(serviceType, region string, newClient func() (*gophercloud.ServiceClient, error)) (*gophercloud.ServiceClient, error) {
+	cache := c.serviceClients
+	if cache == nil {
+		return newClient()
+	}
+
+	key := serviceType + "/" + region
+
+	cache.mu.Lock()
+	entry, ok := cache.entries[key]
+	if !ok {
+		entry = &serviceClientEntry{}
+		cache.entries[key] = entry
+	}
+	cache.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.client, entry.err = newClient()
 	})
+
+	if entry.err != nil {
+		cache.mu.Lock()
+		if cache.entries[key] == entry {
+			delete(cache.entries, key)
+		}
+		cache.mu.Unlock()
+
+		return nil, entry.err
+	}
+
+	client := *entry.client
+	client.ProviderClient = c.osClient
+
+	// The endpoint isn't located again, so tell the recorder about it.
+	if c.apiErrors != nil {
+		c.apiErrors.addEndpoint(client.Endpoint, gophercloud.EndpointOpts{
+			Type:   serviceType,
+			Region: region,
+		})
+	}
+
+	return &client, nil
 }
 
 func (c *Config) getEndpointType() gophercloud.Availability {
@@ -0,0 +1,112 @@
+package openstack
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func TestConfigCachedServiceClient(t *testing.T) {
+	config := &Config{
+		osClient: &gophercloud.ProviderClient{},
+		serviceClients: &serviceClientCache{
+			entries: make(map[string]*serviceClientEntry),
+		},
+	}
+
+	var mu sync.Mutex
+	calls := 0
+	newClient := func() (*gophercloud.ServiceClient, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return &gophercloud.ServiceClient{Endpoint: "https://compute.example.com/v2.1/"}, nil
+	}
+
+	clients := make([]*gophercloud.ServiceClient, 10)
+	var wg sync.WaitGroup
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			client, err := config.cachedServiceClient("compute", "RegionOne", newClient)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			}
+			clients[i] = client
+		}(i)
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("Expected the client to be created once, got %d", calls)
+	}
+
+	clients[0].Microversion = "2.60"
+	for _, client := range clients[1:] {
+		if client.Microversion != "" {
+			t.Fatalf("Expected the clients to be copies")
+		}
+		if client.ProviderClient != config.osClient {
+			t.Fatalf("Expected the clients to use the provider client of the config")
+		}
+	}
+
+	if _, err := config.cachedServiceClient("compute", "RegionTwo", newClient); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Fatalf("Expected a client to be created for another region, got %d calls", calls)
+	}
+}
+
+func TestConfigCachedServiceClientError(t *testing.T) {
+	config := &Config{
+		osClient: &gophercloud.ProviderClient{},
+		serviceClients: &serviceClientCache{
+			entries: make(map[string]*serviceClientEntry),
+		},
+	}
+
+	calls := 0
+	newClient := func() (*gophercloud.ServiceClient, error) {
+		calls++
+		return nil, fmt.Errorf("No suitable endpoint could be found")
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := config.cachedServiceClient("dns", "RegionOne", newClient); err == nil {
+			t.Fatalf("Expected an error")
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected failures not to be cached, got %d calls", calls)
+	}
+}
+
+func TestConfigProjectConfigOwnProject(t *testing.T) {
+	config := &Config{
+		TenantID: "3c3a5d3a94ee45b4b31fe4e8bd4f1b62",
+		osClient: &gophercloud.ProviderClient{},
+		projectClients: &projectClientCache{
+			entries: make(map[string]*projectClientEntry),
+		},
+	}
+
+	for _, projectID := range []string{"", config.TenantID} {
+		projectConfig, err := config.projectConfig(projectID, "RegionOne")
+		if err != nil {
+			t.Fatalf("Unexpected error for project %q: %s", projectID, err)
+		}
+		if projectConfig != config {
+			t.Errorf("Expected project %q to keep the provider config", projectID)
+		}
+	}
+
+	if len(config.projectClients.entries) != 0 {
+		t.Errorf("Expected no project client, got %d", len(config.projectClients.entries))
+	}
+}
@@ -0,0 +1,49 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// containerInfraQuotaV1Resource is the only resource Magnum supports quotas
+// for.
+const containerInfraQuotaV1Resource = "Cluster"
+
+// ContainerInfraQuota is the Magnum quota of a project.
+type ContainerInfraQuota struct {
+	ProjectID string `json:"project_id"`
+	Resource  string `json:"resource"`
+	HardLimit int    `json:"hard_limit"`
+}
+
+func containerInfraQuotaV1Create(client *gophercloud.ServiceClient, quota ContainerInfraQuota) error {
+	var res ContainerInfraQuota
+	_, err := client.Post(client.ServiceURL("quotas"), quota, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func containerInfraQuotaV1Get(client *gophercloud.ServiceClient, projectID string) (*ContainerInfraQuota, error) {
+	var res ContainerInfraQuota
+	_, err := client.Get(client.ServiceURL("quotas", projectID, containerInfraQuotaV1Resource), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func containerInfraQuotaV1Update(client *gophercloud.ServiceClient, quota ContainerInfraQuota) error {
+	var res ContainerInfraQuota
+	_, err := client.Patch(client.ServiceURL("quotas", quota.ProjectID, quota.Resource), quota, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return err
+}
+
+// containerInfraQuotaV1Delete removes the quota of a project, so that the
+// default applies again.
+func containerInfraQuotaV1Delete(client *gophercloud.ServiceClient, projectID string) error {
+	_, err := client.Delete(client.ServiceURL("quotas", projectID, containerInfraQuotaV1Resource), nil)
+	return err
+}
@@ -0,0 +1,171 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBlockStorageVolumesV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageVolumesV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := regexp.Compile(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q is not a valid regular expression: %s", k, err))
+					}
+					return
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"volumes": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"availability_zone": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"volume_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bootable": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"multiattach": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"metadata": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"attached_server_ids": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageVolumesV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	metadata := make(map[string]string)
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		metadata[k] = v.(string)
+	}
+
+	listOpts := BlockStorageVolumeListOpts{
+		volumes.ListOpts{
+			Name:     d.Get("name").(string),
+			Status:   d.Get("status").(string),
+			Metadata: metadata,
+		},
+	}
+
+	log.Printf("[DEBUG] List Options: %#v", listOpts)
+
+	allVolumes, err := blockStorageVolumeV3List(blockStorageClient, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve volumes: %s", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+
+	var ids []string
+	var volumeList []map[string]interface{}
+	for _, volume := range allVolumes {
+		if nameRegex != nil && !nameRegex.MatchString(volume.Name) {
+			continue
+		}
+
+		var serverIds []string
+		for _, attachment := range volume.Attachments {
+			serverIds = append(serverIds, attachment.ServerID)
+		}
+
+		ids = append(ids, volume.ID)
+		volumeList = append(volumeList, map[string]interface{}{
+			"id":                  volume.ID,
+			"name":                volume.Name,
+			"status":              volume.Status,
+			"size":                volume.Size,
+			"availability_zone":   volume.AvailabilityZone,
+			"volume_type":         volume.VolumeType,
+			"bootable":            volume.Bootable == "true",
+			"multiattach":         volume.Multiattach,
+			"metadata":            volume.Metadata,
+			"attached_server_ids": serverIds,
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved volumes: %v", ids)
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%#v-%s", listOpts, d.Get("name_regex").(string)))))
+
+	d.Set("ids", ids)
+	d.Set("volumes", volumeList)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
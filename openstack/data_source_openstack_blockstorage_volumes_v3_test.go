@@ -0,0 +1,73 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackBlockStorageVolumesV3DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackBlockStorageVolumesV3DataSource_volume,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackBlockStorageVolumesV3DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageVolumesV3DataSourceID("data.openstack_blockstorage_volumes_v3.volumes_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_volumes_v3.volumes_1", "volumes.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_volumes_v3.volumes_1", "ids.0",
+						"openstack_blockstorage_volume_v2.volume_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_volumes_v3.volumes_1", "volumes.0.name", "volume_orphan_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_volumes_v3.volumes_1", "volumes.0.metadata.role", "orphan"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageVolumesV3DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find volumes data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Volumes data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackBlockStorageVolumesV3DataSource_volume = `
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_orphan_1"
+  size = 1
+  metadata {
+    role = "orphan"
+  }
+}
+`
+
+var testAccOpenStackBlockStorageVolumesV3DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_volumes_v3" "volumes_1" {
+  name_regex = "^volume_orphan_"
+  status = "available"
+  metadata {
+    role = "${openstack_blockstorage_volume_v2.volume_1.metadata.role}"
+  }
+}
+`, testAccOpenStackBlockStorageVolumesV3DataSource_volume)
@@ -0,0 +1,203 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeFlavorV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"swap": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"rx_tx_factor": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Computed: true,
+			},
+			"extra_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeFlavorV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	var allFlavors []flavors.Flavor
+	if v, ok := d.GetOk("flavor_id"); ok {
+		flavor, err := flavors.Get(computeClient, v.(string)).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve OpenStack flavor %s: %s", v, err)
+		}
+		allFlavors = append(allFlavors, *flavor)
+	} else {
+		listOpts := flavors.ListOpts{
+			MinDisk: d.Get("min_disk").(int),
+			MinRAM:  d.Get("min_ram").(int),
+		}
+
+		allPages, err := flavors.ListDetail(computeClient, listOpts).AllPages()
+		if err != nil {
+			return fmt.Errorf("Unable to query OpenStack flavors: %s", err)
+		}
+
+		allFlavors, err = flavors.ExtractFlavors(allPages)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve OpenStack flavors: %s", err)
+		}
+	}
+
+	var filteredFlavors []flavors.Flavor
+	extraSpecs := make(map[string]map[string]string)
+	for _, flavor := range allFlavors {
+		if v, ok := d.GetOk("name"); ok && flavor.Name != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("ram"); ok && flavor.RAM != v.(int) {
+			continue
+		}
+		if v, ok := d.GetOk("vcpus"); ok && flavor.VCPUs != v.(int) {
+			continue
+		}
+		if v, ok := d.GetOk("disk"); ok && flavor.Disk != v.(int) {
+			continue
+		}
+		if v, ok := d.GetOk("swap"); ok && flavor.Swap != v.(int) {
+			continue
+		}
+		if v, ok := d.GetOk("rx_tx_factor"); ok && flavor.RxTxFactor != v.(float64) {
+			continue
+		}
+
+		// Extra specs need a request per flavor, so they are only
+		// retrieved here when they are part of the query.
+		if _, ok := d.GetOk("extra_specs"); ok {
+			specs, err := computeFlavorV2ExtraSpecs(computeClient, flavor.ID)
+			if err != nil {
+				return fmt.Errorf("Unable to retrieve extra specs of OpenStack flavor %s: %s", flavor.ID, err)
+			}
+
+			if !dataSourceComputeFlavorV2MatchExtraSpecs(d, specs) {
+				continue
+			}
+
+			extraSpecs[flavor.ID] = specs
+		}
+
+		filteredFlavors = append(filteredFlavors, flavor)
+	}
+
+	if len(filteredFlavors) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(filteredFlavors) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	flavor := filteredFlavors[0]
+
+	specs, ok := extraSpecs[flavor.ID]
+	if !ok {
+		var err error
+		specs, err = computeFlavorV2ExtraSpecs(computeClient, flavor.ID)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve extra specs of OpenStack flavor %s: %s", flavor.ID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack flavor %s: %+v", flavor.ID, flavor)
+	d.SetId(flavor.ID)
+
+	d.Set("flavor_id", flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("ram", flavor.RAM)
+	d.Set("vcpus", flavor.VCPUs)
+	d.Set("disk", flavor.Disk)
+	d.Set("swap", flavor.Swap)
+	d.Set("rx_tx_factor", flavor.RxTxFactor)
+	d.Set("extra_specs", specs)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+// dataSourceComputeFlavorV2MatchExtraSpecs reports whether specs contains
+// every extra spec given in the data source's arguments.
+func dataSourceComputeFlavorV2MatchExtraSpecs(d *schema.ResourceData, specs map[string]string) bool {
+	for k, v := range d.Get("extra_specs").(map[string]interface{}) {
+		if specs[k] != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+// computeFlavorV2ExtraSpecs retrieves the extra specs of a flavor. The
+// vendored gophercloud does not support the os-extra_specs API.
+func computeFlavorV2ExtraSpecs(client *gophercloud.ServiceClient, flavorID string) (map[string]string, error) {
+	var res struct {
+		ExtraSpecs map[string]string `json:"extra_specs"`
+	}
+	_, err := client.Get(client.ServiceURL("flavors", flavorID, "os-extra_specs"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.ExtraSpecs, nil
+}
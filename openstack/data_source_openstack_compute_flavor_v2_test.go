@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeV2FlavorDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeV2FlavorDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorDataSourceID("data.openstack_compute_flavor_v2.flavor_1"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_flavor_v2.flavor_1", "name"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_flavor_v2.flavor_1", "ram"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_flavor_v2.flavor_1", "vcpus"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2FlavorDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find flavor data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Flavor data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackComputeV2FlavorDataSource_basic = fmt.Sprintf(`
+data "openstack_compute_flavor_v2" "flavor_1" {
+  flavor_id = "%s"
+  name      = "%s"
+}
+`, OS_FLAVOR_ID, OS_FLAVOR_NAME)
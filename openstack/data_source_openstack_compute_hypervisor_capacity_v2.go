@@ -0,0 +1,176 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeHypervisorCapacityV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeHypervisorCapacityV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"vcpus": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validatePositiveInt,
+			},
+			"ram": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validatePositiveInt,
+			},
+			"disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"instances": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validatePositiveInt,
+			},
+			"group_by": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "availability_zone",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "availability_zone" && value != "aggregate" {
+						errors = append(errors, fmt.Errorf("Only 'availability_zone' and 'aggregate' are supported values for %q", k))
+					}
+					return
+				},
+			},
+			"cpu_allocation_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  1.0,
+			},
+			"ram_allocation_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  1.0,
+			},
+			"disk_allocation_ratio": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  1.0,
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosts": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"capacity": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"free_vcpus": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"free_ram": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"free_disk": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComputeHypervisorCapacityV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	req := ComputeCapacityRequest{
+		VCPUs:               d.Get("vcpus").(int),
+		RAM:                 d.Get("ram").(int),
+		Disk:                d.Get("disk").(int),
+		CPUAllocationRatio:  d.Get("cpu_allocation_ratio").(float64),
+		RAMAllocationRatio:  d.Get("ram_allocation_ratio").(float64),
+		DiskAllocationRatio: d.Get("disk_allocation_ratio").(float64),
+	}
+	instances := d.Get("instances").(int)
+	groupBy := d.Get("group_by").(string)
+
+	hypervisors, err := computeHypervisorsV2List(computeClient)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve OpenStack hypervisors: %s", err)
+	}
+
+	var groups map[string][]string
+	switch groupBy {
+	case "aggregate":
+		groups, err = computeAggregateHostsV2(computeClient)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve OpenStack host aggregates: %s", err)
+		}
+	default:
+		groups, err = computeAvailabilityZoneHostsV2(computeClient)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve OpenStack availability zones: %s", err)
+		}
+	}
+
+	capacities := computeCapacityV2(hypervisors, groups, req)
+	log.Printf("[DEBUG] Retrieved OpenStack hypervisor capacity: %+v", capacities)
+
+	names := []string{}
+	groupList := make([]map[string]interface{}, 0, len(capacities))
+	for _, c := range capacities {
+		if c.Instances >= instances {
+			names = append(names, c.Name)
+		}
+
+		groupList = append(groupList, map[string]interface{}{
+			"name":       c.Name,
+			"hosts":      c.Hosts,
+			"capacity":   c.Instances,
+			"free_vcpus": c.FreeVCPUs,
+			"free_ram":   c.FreeRAM,
+			"free_disk":  c.FreeDisk,
+		})
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%#v-%d-%s", req, instances, groupBy))))
+
+	d.Set("names", names)
+	d.Set("groups", groupList)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
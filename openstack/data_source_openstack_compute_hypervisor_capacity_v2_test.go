@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeV2HypervisorCapacityDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeV2HypervisorCapacityDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2HypervisorCapacityDataSourceID("data.openstack_compute_hypervisor_capacity_v2.capacity_1"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_hypervisor_capacity_v2.capacity_1", "groups.0.name"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_hypervisor_capacity_v2.capacity_1", "groups.0.capacity"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2HypervisorCapacityDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find hypervisor capacity data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Hypervisor capacity data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackComputeV2HypervisorCapacityDataSource_basic = `
+data "openstack_compute_hypervisor_capacity_v2" "capacity_1" {
+  vcpus = 1
+  ram   = 512
+}
+`
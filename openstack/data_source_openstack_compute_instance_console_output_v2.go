@@ -0,0 +1,64 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeInstanceConsoleOutputV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstanceConsoleOutputV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < 0 {
+						errors = append(errors, fmt.Errorf("%q must not be negative", k))
+					}
+					return
+				},
+			},
+			"console_output": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceConsoleOutputV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+
+	output, err := getServerConsoleOutput(computeClient, instanceID, d.Get("length").(int))
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve console output of OpenStack instance %s: %s", instanceID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d bytes of console output of OpenStack instance %s", len(output), instanceID)
+	d.SetId(instanceID)
+
+	d.Set("console_output", output)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
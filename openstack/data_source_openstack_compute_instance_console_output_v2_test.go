@@ -0,0 +1,55 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeV2InstanceConsoleOutputDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeV2InstanceConsoleOutputDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceConsoleOutputDataSourceID("data.openstack_compute_instance_console_output_v2.output_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_console_output_v2.output_1", "length", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2InstanceConsoleOutputDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find instance console output data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Instance console output data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackComputeV2InstanceConsoleOutputDataSource_basic = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+data "openstack_compute_instance_console_output_v2" "output_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  length = 10
+}
+`
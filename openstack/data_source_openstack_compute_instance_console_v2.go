@@ -0,0 +1,67 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeInstanceConsoleV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstanceConsoleV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "novnc",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if _, ok := computeConsoleV2Actions[value]; !ok {
+						errors = append(errors, fmt.Errorf(
+							"Only 'novnc', 'xvpvnc', 'spice-html5', 'rdp-html5' and 'serial' are supported values for 'type'"))
+					}
+					return
+				},
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceConsoleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	consoleType := d.Get("type").(string)
+
+	console, err := computeConsoleV2Get(computeClient, instanceID, consoleType)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve %s console of OpenStack instance %s: %s", consoleType, instanceID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %s console of OpenStack instance %s", consoleType, instanceID)
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, consoleType))
+
+	d.Set("url", console.URL)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeV2InstanceConsoleDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeV2InstanceConsoleDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceConsoleDataSourceID("data.openstack_compute_instance_console_v2.console_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_console_v2.console_1", "type", "novnc"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_compute_instance_console_v2.console_1", "url", regexp.MustCompile("^https?://")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2InstanceConsoleDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find instance console data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Instance console data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackComputeV2InstanceConsoleDataSource_basic = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+data "openstack_compute_instance_console_v2" "console_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+}
+`
@@ -0,0 +1,59 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeKeypairV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeKeypairV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"public_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeKeypairV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	kp, err := keypairs.Get(computeClient, name).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve OpenStack keypair %s: %s", name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack keypair %s: %+v", name, kp)
+	d.SetId(kp.Name)
+
+	d.Set("name", kp.Name)
+	d.Set("public_key", kp.PublicKey)
+	d.Set("fingerprint", kp.Fingerprint)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeV2KeypairDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Keypair_basic,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackComputeV2KeypairDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2KeypairDataSourceID("data.openstack_compute_keypair_v2.kp_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_keypair_v2.kp_1", "name", "kp_1"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_keypair_v2.kp_1", "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2KeypairDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find keypair data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Keypair data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackComputeV2KeypairDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_compute_keypair_v2" "kp_1" {
+  name = "${openstack_compute_keypair_v2.kp_1.name}"
+}
+`, testAccComputeV2Keypair_basic)
@@ -0,0 +1,107 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeLimitsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeLimitsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"instances": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"max_total_instances": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_total_vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_total_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_instances_used": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_vcpus_used": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_ram_used": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remaining_instances": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remaining_vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remaining_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeLimitsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	limits, err := computeLimitsV2Get(computeClient)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve compute limits: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved compute limits: %+v", limits)
+
+	err = computeLimitsV2Check(limits, d.Get("instances").(int), d.Get("vcpus").(int), d.Get("ram").(int))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(GetRegion(d))))
+
+	d.Set("max_total_instances", limits.MaxTotalInstances)
+	d.Set("max_total_vcpus", limits.MaxTotalCores)
+	d.Set("max_total_ram", limits.MaxTotalRAMSize)
+	d.Set("total_instances_used", limits.TotalInstancesUsed)
+	d.Set("total_vcpus_used", limits.TotalCoresUsed)
+	d.Set("total_ram_used", limits.TotalRAMUsed)
+	d.Set("remaining_instances", computeLimitsV2Remaining(limits.MaxTotalInstances, limits.TotalInstancesUsed))
+	d.Set("remaining_vcpus", computeLimitsV2Remaining(limits.MaxTotalCores, limits.TotalCoresUsed))
+	d.Set("remaining_ram", computeLimitsV2Remaining(limits.MaxTotalRAMSize, limits.TotalRAMUsed))
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
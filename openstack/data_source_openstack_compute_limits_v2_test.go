@@ -0,0 +1,73 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeV2LimitsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeV2LimitsDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2LimitsDataSourceID("data.openstack_compute_limits_v2.limits_1"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_limits_v2.limits_1", "max_total_instances"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_limits_v2.limits_1", "remaining_vcpus"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackComputeV2LimitsDataSource_exceeded(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccOpenStackComputeV2LimitsDataSource_exceeded,
+				ExpectError: regexp.MustCompile("exceed the remaining compute quota"),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2LimitsDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find limits data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Limits data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackComputeV2LimitsDataSource_basic = `
+data "openstack_compute_limits_v2" "limits_1" {
+  instances = 1
+}
+`
+
+const testAccOpenStackComputeV2LimitsDataSource_exceeded = `
+data "openstack_compute_limits_v2" "limits_1" {
+  instances = 1000000
+}
+`
@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeServerGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeServerGroupV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policies": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"members": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceComputeServerGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	allPages, err := servergroups.List(computeClient).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list server groups: %s", err)
+	}
+
+	allServerGroups, err := servergroups.ExtractServerGroups(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve server groups: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	var serverGroups []servergroups.ServerGroup
+	for _, sg := range allServerGroups {
+		if sg.Name == name {
+			serverGroups = append(serverGroups, sg)
+		}
+	}
+
+	if len(serverGroups) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(serverGroups) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	sg := serverGroups[0]
+
+	log.Printf("[DEBUG] Retrieved ServerGroup %s: %+v", sg.ID, sg)
+	d.SetId(sg.ID)
+
+	d.Set("name", sg.Name)
+	d.Set("policies", sg.Policies)
+	d.Set("members", sg.Members)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
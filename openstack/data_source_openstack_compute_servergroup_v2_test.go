@@ -0,0 +1,56 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeV2ServerGroupDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2ServerGroup_basic,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackComputeV2ServerGroupDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupDataSourceID("data.openstack_compute_servergroup_v2.sg_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_servergroup_v2.sg_1", "name", "sg_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_servergroup_v2.sg_1", "policies.0", "affinity"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2ServerGroupDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find server group data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Server group data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackComputeV2ServerGroupDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_compute_servergroup_v2" "sg_1" {
+  name = "${openstack_compute_servergroup_v2.sg_1.name}"
+}
+`, testAccComputeV2ServerGroup_basic)
@@ -0,0 +1,132 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIdentityRoleAssignmentsV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityRoleAssignmentsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"effective": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"role_assignments": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inherited": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIdentityRoleAssignmentsV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	listOpts := IdentityRoleAssignmentListOpts{
+		UserID:    d.Get("user_id").(string),
+		GroupID:   d.Get("group_id").(string),
+		ProjectID: d.Get("project_id").(string),
+		DomainID:  d.Get("domain_id").(string),
+		RoleID:    d.Get("role_id").(string),
+		Effective: d.Get("effective").(bool),
+	}
+
+	// Keystone replaces the group assignments by the assignments of the
+	// group members when listing effective assignments, so both can't be
+	// asked for.
+	if listOpts.GroupID != "" && listOpts.Effective {
+		return fmt.Errorf("group_id can only be used when effective is false")
+	}
+
+	log.Printf("[DEBUG] List Options: %#v", listOpts)
+
+	assignments, err := identityRoleAssignmentV3List(identityClient, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve role assignments: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved role assignments: %+v", assignments)
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%#v", listOpts))))
+
+	assignmentList := make([]map[string]interface{}, len(assignments))
+	for i, assignment := range assignments {
+		assignmentList[i] = map[string]interface{}{
+			"role_id":    assignment.Role.ID,
+			"user_id":    assignment.User.ID,
+			"group_id":   assignment.Group.ID,
+			"project_id": assignment.Scope.Project.ID,
+			"domain_id":  assignment.Scope.Domain.ID,
+			"inherited":  assignment.Scope.InheritedTo != "",
+		}
+	}
+
+	d.Set("role_assignments", assignmentList)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackIdentityRoleAssignmentsV3DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckTenant(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackIdentityRoleAssignmentsV3DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityRoleAssignmentsV3DataSourceID("data.openstack_identity_role_assignments_v3.assignments_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_role_assignments_v3.assignments_1", "role_assignments.0.project_id", OS_TENANT_ID),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_identity_role_assignments_v3.assignments_1", "role_assignments.0.role_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityRoleAssignmentsV3DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find role assignments data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Role assignments data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackIdentityRoleAssignmentsV3DataSource_basic = fmt.Sprintf(`
+data "openstack_identity_role_assignments_v3" "assignments_1" {
+  project_id = "%s"
+}
+`, OS_TENANT_ID)
@@ -117,6 +117,21 @@ func dataSourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"virtual_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"hash_algo": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hash_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"metadata": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -163,8 +178,22 @@ func dataSourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error
 	}
 
 	var allImages []images.Image
+	allExtensions := make(map[string]imageExtensions)
 	pager := images.List(imageClient, listOpts)
 	err = pager.EachPage(func(page pagination.Page) (bool, error) {
+		// The attributes missing from images.Image are extracted first, as
+		// the images package is shadowed below.
+		var s struct {
+			Images []imageExtensions `json:"images"`
+		}
+		if err := page.(images.ImagePage).ExtractInto(&s); err != nil {
+			return false, err
+		}
+
+		for _, ext := range s.Images {
+			allExtensions[ext.ID] = ext
+		}
+
 		images, err := images.ExtractImages(page)
 		if err != nil {
 			return false, err
@@ -200,11 +229,11 @@ func dataSourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] openstack_images_image: Single Image found: %s", image.ID)
-	return dataSourceImagesImageV2Attributes(d, &image)
+	return dataSourceImagesImageV2Attributes(d, &image, allExtensions[image.ID])
 }
 
 // dataSourceImagesImageV2Attributes populates the fields of an Image resource.
-func dataSourceImagesImageV2Attributes(d *schema.ResourceData, image *images.Image) error {
+func dataSourceImagesImageV2Attributes(d *schema.ResourceData, image *images.Image, ext imageExtensions) error {
 	log.Printf("[DEBUG] openstack_images_image details: %#v", image)
 
 	d.SetId(image.ID)
@@ -219,6 +248,9 @@ func dataSourceImagesImageV2Attributes(d *schema.ResourceData, image *images.Ima
 	d.Set("visibility", image.Visibility)
 	d.Set("checksum", image.Checksum)
 	d.Set("size_bytes", image.SizeBytes)
+	d.Set("virtual_size", ext.VirtualSize)
+	d.Set("hash_algo", ext.HashAlgo)
+	d.Set("hash_value", ext.HashValue)
 	d.Set("metadata", image.Metadata)
 	d.Set("created_at", image.CreatedAt)
 	d.Set("updated_at", image.UpdatedAt)
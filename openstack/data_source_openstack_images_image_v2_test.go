@@ -34,6 +34,12 @@ func TestAccOpenStackImagesV2ImageDataSource_basic(t *testing.T) {
 						"data.openstack_images_image_v2.image_1", "protected", "false"),
 					resource.TestCheckResourceAttr(
 						"data.openstack_images_image_v2.image_1", "visibility", "private"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_images_image_v2.image_1", "checksum",
+						"openstack_images_image_v2.image_1", "checksum"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_images_image_v2.image_1", "virtual_size",
+						"openstack_images_image_v2.image_1", "virtual_size"),
 				),
 			},
 		},
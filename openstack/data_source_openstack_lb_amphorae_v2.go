@@ -0,0 +1,107 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLBAmphoraeV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLBAmphoraeV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"loadbalancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"amphorae": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compute_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lb_network_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrrp_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"image_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cert_expiration": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBAmphoraeV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	amphorae, err := lbAmphoraV2List(networkingClient, lbID)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve amphorae of LBaaSV2 load balancer %s: %s", lbID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved amphorae of LBaaSV2 load balancer %s: %+v", lbID, amphorae)
+	d.SetId(lbID)
+
+	amphoraList := make([]map[string]interface{}, len(amphorae))
+	for i, amphora := range amphorae {
+		amphoraList[i] = map[string]interface{}{
+			"id":              amphora.ID,
+			"compute_id":      amphora.ComputeID,
+			"role":            amphora.Role,
+			"status":          amphora.Status,
+			"lb_network_ip":   amphora.LBNetworkIP,
+			"ha_ip":           amphora.HAIP,
+			"vrrp_ip":         amphora.VRRPIP,
+			"image_id":        amphora.ImageID,
+			"cert_expiration": amphora.CertExpiration,
+		}
+	}
+
+	d.Set("amphorae", amphoraList)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackLBAmphoraeV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2AmphoraLoadBalancerConfig,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackLBAmphoraeV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBAmphoraeV2DataSourceID("data.openstack_lb_amphorae_v2.amphorae_1"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.compute_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBAmphoraeV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find amphorae data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Amphorae data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+// testAccLBV2AmphoraLoadBalancerConfig uses the default provider, because
+// only Octavia's amphora provider has amphorae.
+const testAccLBV2AmphoraLoadBalancerConfig = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+`
+
+var testAccOpenStackLBAmphoraeV2DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+`, testAccLBV2AmphoraLoadBalancerConfig)
@@ -0,0 +1,84 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLBFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLBFlavorV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flavor_profile_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBFlavorV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := LBFlavorListOpts{
+		ID:   d.Get("flavor_id").(string),
+		Name: d.Get("name").(string),
+	}
+
+	allFlavors, err := lbFlavorV2List(networkingClient, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve LBaaSV2 flavors: %s", err)
+	}
+
+	if len(allFlavors) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allFlavors) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	flavor := allFlavors[0]
+
+	log.Printf("[DEBUG] Retrieved LBaaSV2 flavor %s: %+v", flavor.ID, flavor)
+	d.SetId(flavor.ID)
+
+	d.Set("flavor_id", flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("description", flavor.Description)
+	d.Set("flavor_profile_id", flavor.FlavorProfileID)
+	d.Set("enabled", flavor.Enabled)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
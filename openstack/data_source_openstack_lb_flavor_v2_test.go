@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackLBFlavorV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2FlavorConfig_basic,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackLBFlavorV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBFlavorV2DataSourceID("data.openstack_lb_flavor_v2.flavor_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_flavor_v2.flavor_1", "name", "flavor_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_flavor_v2.flavor_1", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBFlavorV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find flavor data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Flavor data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackLBFlavorV2DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_lb_flavor_v2" "flavor_1" {
+  name = "${openstack_lb_flavor_v2.flavor_1.name}"
+}
+`, testAccLBV2FlavorConfig_basic)
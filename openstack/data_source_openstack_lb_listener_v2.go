@@ -0,0 +1,172 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLBListenerV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLBListenerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"loadbalancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"protocol_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_pool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_limit": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"default_tls_container_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sni_container_refs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tls_ciphers": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tls_versions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"alpn_protocols": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceLBListenerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := listeners.ListOpts{
+		ID:             d.Get("listener_id").(string),
+		Name:           d.Get("name").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		Protocol:       d.Get("protocol").(string),
+		ProtocolPort:   d.Get("protocol_port").(int),
+		TenantID:       d.Get("tenant_id").(string),
+	}
+
+	allPages, err := listeners.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query LBaaSV2 listeners: %s", err)
+	}
+
+	var s struct {
+		Listeners []Listener `json:"listeners"`
+	}
+	if err := allPages.(listeners.ListenerPage).ExtractInto(&s); err != nil {
+		return fmt.Errorf("Unable to retrieve LBaaSV2 listeners: %s", err)
+	}
+
+	var refinedListeners []Listener
+	for _, listener := range s.Listeners {
+		if dataSourceLBV2MatchTags(d, listener.Tags) {
+			refinedListeners = append(refinedListeners, listener)
+		}
+	}
+
+	if len(refinedListeners) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedListeners) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	listener := refinedListeners[0]
+
+	log.Printf("[DEBUG] Retrieved LBaaSV2 listener %s: %+v", listener.ID, listener)
+	d.SetId(listener.ID)
+
+	d.Set("listener_id", listener.ID)
+	d.Set("name", listener.Name)
+	d.Set("protocol", listener.Protocol)
+	d.Set("protocol_port", listener.ProtocolPort)
+	d.Set("tenant_id", listener.TenantID)
+	d.Set("tags", listener.Tags)
+	d.Set("description", listener.Description)
+	d.Set("default_pool_id", listener.DefaultPoolID)
+	d.Set("connection_limit", listener.ConnLimit)
+	d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
+	d.Set("sni_container_refs", listener.SniContainerRefs)
+	d.Set("admin_state_up", listener.AdminStateUp)
+	d.Set("tls_ciphers", listener.TLSCiphers)
+	d.Set("tls_versions", listener.TLSVersions)
+	d.Set("alpn_protocols", listener.ALPNProtocols)
+	d.Set("region", GetRegion(d))
+
+	if len(listener.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", listener.Loadbalancers[0].ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLBLoadBalancerV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLBLoadBalancerV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"loadbalancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vip_subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vip_port_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioning_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"operating_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"loadbalancer_provider": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := loadbalancers.ListOpts{
+		ID:          d.Get("loadbalancer_id").(string),
+		Name:        d.Get("name").(string),
+		VipAddress:  d.Get("vip_address").(string),
+		VipSubnetID: d.Get("vip_subnet_id").(string),
+		TenantID:    d.Get("tenant_id").(string),
+	}
+
+	allPages, err := loadbalancers.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query LBaaSV2 load balancers: %s", err)
+	}
+
+	var s struct {
+		LoadBalancers []LoadBalancer `json:"loadbalancers"`
+	}
+	if err := allPages.(loadbalancers.LoadBalancerPage).ExtractInto(&s); err != nil {
+		return fmt.Errorf("Unable to retrieve LBaaSV2 load balancers: %s", err)
+	}
+
+	var refinedLoadBalancers []LoadBalancer
+	for _, lb := range s.LoadBalancers {
+		if dataSourceLBV2MatchTags(d, lb.Tags) {
+			refinedLoadBalancers = append(refinedLoadBalancers, lb)
+		}
+	}
+
+	if len(refinedLoadBalancers) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedLoadBalancers) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	lb := refinedLoadBalancers[0]
+
+	log.Printf("[DEBUG] Retrieved LBaaSV2 load balancer %s: %+v", lb.ID, lb)
+	d.SetId(lb.ID)
+
+	d.Set("loadbalancer_id", lb.ID)
+	d.Set("name", lb.Name)
+	d.Set("description", lb.Description)
+	d.Set("vip_address", lb.VipAddress)
+	d.Set("vip_subnet_id", lb.VipSubnetID)
+	d.Set("vip_port_id", lb.VipPortID)
+	d.Set("tenant_id", lb.TenantID)
+	d.Set("provisioning_status", lb.ProvisioningStatus)
+	d.Set("operating_status", lb.OperatingStatus)
+	d.Set("admin_state_up", lb.AdminStateUp)
+	d.Set("flavor_id", lb.FlavorID)
+	d.Set("loadbalancer_provider", lb.Provider)
+	d.Set("tags", lb.Tags)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+// dataSourceLBV2MatchTags reports whether tags contains every tag given in
+// the data source's arguments. The tags are filtered here rather than in the
+// query, because only Octavia supports filtering by tags.
+func dataSourceLBV2MatchTags(d *schema.ResourceData, tags []string) bool {
+	for _, tag := range d.Get("tags").(*schema.Set).List() {
+		if !strSliceContains(tags, tag.(string)) {
+			return false
+		}
+	}
+	return true
+}
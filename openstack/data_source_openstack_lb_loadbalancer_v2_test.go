@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackLBLoadBalancerV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2LoadBalancerConfig_basic,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackLBLoadBalancerV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBLoadBalancerV2DataSourceID("data.openstack_lb_loadbalancer_v2.lb_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_v2.lb_1", "name", "loadbalancer_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_v2.lb_1", "provisioning_status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_loadbalancer_v2.lb_1", "vip_port_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackLBLoadBalancerV2DataSource_tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2LoadBalancerConfig_tags_1,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackLBLoadBalancerV2DataSource_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBLoadBalancerV2DataSourceID("data.openstack_lb_loadbalancer_v2.lb_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_loadbalancer_v2.lb_1", "tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBLoadBalancerV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find load balancer data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Load balancer data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackLBLoadBalancerV2DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_lb_loadbalancer_v2" "lb_1" {
+  vip_address = "${openstack_lb_loadbalancer_v2.loadbalancer_1.vip_address}"
+}
+`, testAccLBV2LoadBalancerConfig_basic)
+
+var testAccOpenStackLBLoadBalancerV2DataSource_tags = fmt.Sprintf(`
+%s
+
+data "openstack_lb_loadbalancer_v2" "lb_1" {
+  name = "${openstack_lb_loadbalancer_v2.loadbalancer_1.name}"
+  tags = ["foo"]
+}
+`, testAccLBV2LoadBalancerConfig_tags_1)
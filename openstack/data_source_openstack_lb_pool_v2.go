@@ -0,0 +1,159 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLBPoolV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLBPoolV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"pool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"loadbalancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"lb_method": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"monitor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tls_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tls_ciphers": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tls_versions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceLBPoolV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := pools.ListOpts{
+		ID:             d.Get("pool_id").(string),
+		Name:           d.Get("name").(string),
+		LoadbalancerID: d.Get("loadbalancer_id").(string),
+		ListenerID:     d.Get("listener_id").(string),
+		Protocol:       d.Get("protocol").(string),
+		LBMethod:       d.Get("lb_method").(string),
+		TenantID:       d.Get("tenant_id").(string),
+	}
+
+	allPages, err := pools.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query LBaaSV2 pools: %s", err)
+	}
+
+	var s struct {
+		Pools []Pool `json:"pools"`
+	}
+	if err := allPages.(pools.PoolPage).ExtractInto(&s); err != nil {
+		return fmt.Errorf("Unable to retrieve LBaaSV2 pools: %s", err)
+	}
+
+	var refinedPools []Pool
+	for _, pool := range s.Pools {
+		if dataSourceLBV2MatchTags(d, pool.Tags) {
+			refinedPools = append(refinedPools, pool)
+		}
+	}
+
+	if len(refinedPools) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(refinedPools) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	pool := refinedPools[0]
+
+	log.Printf("[DEBUG] Retrieved LBaaSV2 pool %s: %+v", pool.ID, pool)
+	d.SetId(pool.ID)
+
+	d.Set("pool_id", pool.ID)
+	d.Set("name", pool.Name)
+	d.Set("protocol", pool.Protocol)
+	d.Set("lb_method", pool.LBMethod)
+	d.Set("tenant_id", pool.TenantID)
+	d.Set("tags", pool.Tags)
+	d.Set("description", pool.Description)
+	d.Set("monitor_id", pool.MonitorID)
+	d.Set("admin_state_up", pool.AdminStateUp)
+	d.Set("tls_enabled", pool.TLSEnabled)
+	d.Set("tls_ciphers", pool.TLSCiphers)
+	d.Set("tls_versions", pool.TLSVersions)
+	d.Set("region", GetRegion(d))
+
+	if len(pool.Loadbalancers) > 0 {
+		d.Set("loadbalancer_id", pool.Loadbalancers[0].ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackLBPoolV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2PoolConfig_basic,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackLBPoolV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBPoolV2DataSourceID("data.openstack_lb_pool_v2.pool_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_pool_v2.pool_1", "name", "pool_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_pool_v2.pool_1", "protocol", "HTTP"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBPoolV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find pool data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Pool data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackLBPoolV2DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_lb_pool_v2" "pool_1" {
+  name            = "${openstack_lb_pool_v2.pool_1.name}"
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+`, TestAccLBV2PoolConfig_basic)
@@ -0,0 +1,122 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworkingAvailabilityZonesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingAvailabilityZonesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"resource": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "network" && value != "router" {
+						errors = append(errors, fmt.Errorf("Only 'network' and 'router' are supported values for %q", k))
+					}
+					return
+				},
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "available",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "available" && value != "unavailable" {
+						errors = append(errors, fmt.Errorf("Only 'available' and 'unavailable' are supported values for %q", k))
+					}
+					return
+				},
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"availability_zones": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingAvailabilityZonesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	resourceType := d.Get("resource").(string)
+	state := d.Get("state").(string)
+
+	zones, err := networkingAvailabilityZonesV2List(networkingClient, resourceType, state)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve OpenStack Neutron availability zones: %s", err)
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack Neutron availability zones: %+v", zones)
+
+	sort.SliceStable(zones, func(i, j int) bool {
+		if zones[i].Name != zones[j].Name {
+			return zones[i].Name < zones[j].Name
+		}
+		return zones[i].Resource < zones[j].Resource
+	})
+
+	// A zone serving both networks and routers is listed twice, but its
+	// name is only given once in names.
+	names := make([]string, 0, len(zones))
+	zoneList := make([]map[string]interface{}, len(zones))
+	for i, zone := range zones {
+		if len(names) == 0 || names[len(names)-1] != zone.Name {
+			names = append(names, zone.Name)
+		}
+
+		zoneList[i] = map[string]interface{}{
+			"name":     zone.Name,
+			"resource": zone.Resource,
+			"state":    zone.State,
+		}
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%s-%s-%s", GetRegion(d), resourceType, state))))
+
+	d.Set("names", names)
+	d.Set("availability_zones", zoneList)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackNetworkingAvailabilityZonesV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingAvailabilityZonesV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingAvailabilityZonesV2DataSourceID("data.openstack_networking_availability_zones_v2.zones_1"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_networking_availability_zones_v2.zones_1", "names.#", regexp.MustCompile("^[1-9][0-9]*$")),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_availability_zones_v2.zones_1", "availability_zones.0.resource", "router"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_availability_zones_v2.zones_1", "availability_zones.0.state", "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingAvailabilityZonesV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find availability zones data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Availability zones data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackNetworkingAvailabilityZonesV2DataSource_basic = `
+data "openstack_networking_availability_zones_v2" "zones_1" {
+  resource = "router"
+}
+`
@@ -29,6 +29,11 @@ func dataSourceNetworkingNetworkV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"external": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"region": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
@@ -51,6 +56,36 @@ func dataSourceNetworkingNetworkV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mtu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags_any": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"all_tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -59,20 +94,31 @@ func dataSourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{})
 	config := meta.(*Config)
 	networkingClient, err := config.networkingV2Client(GetRegion(d))
 
-	listOpts := networks.ListOpts{
-		ID:       d.Get("network_id").(string),
-		Name:     d.Get("name").(string),
-		TenantID: d.Get("tenant_id").(string),
-		Status:   "ACTIVE",
+	listOpts := NetworkingNetworkListOpts{
+		ListOpts: networks.ListOpts{
+			ID:       d.Get("network_id").(string),
+			Name:     d.Get("name").(string),
+			TenantID: d.Get("tenant_id").(string),
+			Status:   "ACTIVE",
+		},
+		Tags:    networkingV2AttributesTags(d),
+		TagsAny: networkingV2SortedTags(d.Get("tags_any").(*schema.Set)),
+	}
+
+	// External networks are usually owned by the cloud administrator, so
+	// don't filter them by the tenant_id, which defaults to the current one.
+	external := d.Get("external").(bool)
+	if external {
+		listOpts.External = &external
+		listOpts.TenantID = ""
 	}
 
-	pages, err := networks.List(networkingClient, listOpts).AllPages()
-	allNetworks, err := networks.ExtractNetworks(pages)
+	allNetworks, err := networkingNetworkV2List(networkingClient, listOpts)
 	if err != nil {
 		return fmt.Errorf("Unable to retrieve networks: %s", err)
 	}
 
-	var refinedNetworks []networks.Network
+	var refinedNetworks []NetworkingNetwork
 	if cidr := d.Get("matching_subnet_cidr").(string); cidr != "" {
 		for _, n := range allNetworks {
 			for _, s := range n.Subnets {
@@ -97,6 +143,13 @@ func dataSourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{})
 			"Please change your search criteria and try again.")
 	}
 
+	// Several external networks may be usable, so rank them as configured
+	// in the provider.
+	if external && len(refinedNetworks) > 1 {
+		refinedNetworks = networkingNetworkV2RankExternal(refinedNetworks,
+			config.ExternalNetworkTagPriority, config.ExternalNetworkPreferDefault)
+	}
+
 	if len(refinedNetworks) > 1 {
 		return fmt.Errorf("Your query returned more than one result." +
 			" Please try a more specific search criteria")
@@ -111,6 +164,10 @@ func dataSourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{})
 	d.Set("admin_state_up", strconv.FormatBool(network.AdminStateUp))
 	d.Set("shared", strconv.FormatBool(network.Shared))
 	d.Set("tenant_id", network.TenantID)
+	d.Set("is_default", network.IsDefault)
+	d.Set("qos_policy_id", network.QoSPolicyID)
+	d.Set("mtu", network.MTU)
+	d.Set("all_tags", network.Tags)
 	d.Set("region", GetRegion(d))
 
 	return nil
@@ -24,6 +24,9 @@ func TestAccOpenStackNetworkingNetworkV2DataSource_basic(t *testing.T) {
 						"data.openstack_networking_network_v2.net", "name", "tf_test_network"),
 					resource.TestCheckResourceAttr(
 						"data.openstack_networking_network_v2.net", "admin_state_up", "true"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_network_v2.net", "mtu",
+						"openstack_networking_network_v2.net", "mtu"),
 				),
 			},
 		},
@@ -74,6 +77,45 @@ func TestAccOpenStackNetworkingNetworkV2DataSource_networkID(t *testing.T) {
 	})
 }
 
+func TestAccOpenStackNetworkingNetworkV2DataSource_tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingNetworkV2DataSource_tagged,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingNetworkV2DataSource_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingNetworkV2DataSourceID("data.openstack_networking_network_v2.net"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_v2.net", "name", "tf_test_network"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_v2.net", "all_tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackNetworkingNetworkV2DataSource_external(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingNetworkV2DataSource_external,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingNetworkV2DataSourceID("data.openstack_networking_network_v2.net"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_network_v2.net", "name", OS_POOL_NAME),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingNetworkV2DataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -126,3 +168,27 @@ data "openstack_networking_network_v2" "net" {
 	network_id = "${openstack_networking_network_v2.net.id}"
 }
 `, testAccOpenStackNetworkingNetworkV2DataSource_network)
+
+var testAccOpenStackNetworkingNetworkV2DataSource_external = fmt.Sprintf(`
+data "openstack_networking_network_v2" "net" {
+  name = "%s"
+  external = true
+}
+`, OS_POOL_NAME)
+
+const testAccOpenStackNetworkingNetworkV2DataSource_tagged = `
+resource "openstack_networking_network_v2" "net" {
+  name = "tf_test_network"
+  admin_state_up = "true"
+  tags = ["tf_test_tag_1", "tf_test_tag_2"]
+}
+`
+
+var testAccOpenStackNetworkingNetworkV2DataSource_tags = fmt.Sprintf(`
+%s
+
+data "openstack_networking_network_v2" "net" {
+  tags = ["tf_test_tag_1"]
+  tags_any = ["tf_test_tag_2", "tf_test_tag_3"]
+}
+`, testAccOpenStackNetworkingNetworkV2DataSource_tagged)
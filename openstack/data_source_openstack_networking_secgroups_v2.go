@@ -0,0 +1,157 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworkingSecGroupsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingSecGroupsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := regexp.Compile(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q is not a valid regular expression: %s", k, err))
+					}
+					return
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"OS_TENANT_ID",
+					"OS_PROJECT_ID",
+				}, ""),
+				Description: descriptions["tenant_id"],
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags_any": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"all_tags": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingSecGroupsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := NetworkingSecGroupListOpts{
+		ListOpts: groups.ListOpts{
+			Name:     d.Get("name").(string),
+			TenantID: d.Get("tenant_id").(string),
+		},
+		Tags:    networkingV2AttributesTags(d),
+		TagsAny: networkingV2SortedTags(d.Get("tags_any").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] List Options: %#v", listOpts)
+
+	allSecGroups, err := networkingSecGroupV2List(networkingClient, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve security groups: %s", err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v := d.Get("name_regex").(string); v != "" {
+		nameRegex = regexp.MustCompile(v)
+	}
+
+	var ids, names []string
+	var secGroupList []map[string]interface{}
+	for _, secGroup := range allSecGroups {
+		if nameRegex != nil && !nameRegex.MatchString(secGroup.Name) {
+			continue
+		}
+
+		ids = append(ids, secGroup.ID)
+		names = append(names, secGroup.Name)
+		secGroupList = append(secGroupList, map[string]interface{}{
+			"id":          secGroup.ID,
+			"name":        secGroup.Name,
+			"description": secGroup.Description,
+			"tenant_id":   secGroup.TenantID,
+			"all_tags":    secGroup.Tags,
+		})
+	}
+
+	log.Printf("[DEBUG] Retrieved security groups: %v", ids)
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%#v-%s", listOpts, d.Get("name_regex").(string)))))
+
+	d.Set("ids", ids)
+	d.Set("names", names)
+	d.Set("security_groups", secGroupList)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackNetworkingSecGroupsV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingSecGroupsV2DataSource_group,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingSecGroupsV2DataSource_nameRegex,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingSecGroupsV2DataSourceID("data.openstack_networking_secgroups_v2.secgroups_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_secgroups_v2.secgroups_1", "ids.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingSecGroupsV2DataSource_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingSecGroupsV2DataSourceID("data.openstack_networking_secgroups_v2.secgroups_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_secgroups_v2.secgroups_1", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_secgroups_v2.secgroups_1", "ids.0",
+						"openstack_networking_secgroup_v2.secgroup_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_secgroups_v2.secgroups_1", "names.0", "tf_test_baseline_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingSecGroupsV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find security groups data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Security groups data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackNetworkingSecGroupsV2DataSource_group = `
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "tf_test_baseline_1"
+  tags = ["baseline"]
+}
+
+resource "openstack_networking_secgroup_v2" "secgroup_2" {
+  name = "tf_test_baseline_2"
+}
+`
+
+var testAccOpenStackNetworkingSecGroupsV2DataSource_nameRegex = fmt.Sprintf(`
+%s
+
+data "openstack_networking_secgroups_v2" "secgroups_1" {
+  name_regex = "^tf_test_baseline_"
+}
+`, testAccOpenStackNetworkingSecGroupsV2DataSource_group)
+
+var testAccOpenStackNetworkingSecGroupsV2DataSource_tags = fmt.Sprintf(`
+%s
+
+data "openstack_networking_secgroups_v2" "secgroups_1" {
+  name_regex = "^tf_test_baseline_"
+  tags = ["baseline"]
+}
+`, testAccOpenStackNetworkingSecGroupsV2DataSource_group)
@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceObjectStorageContainerV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageContainerV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delimiter": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"container_read": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_write": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versions_location": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"object_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bytes_used": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"objects": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subdirs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageContainerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+
+	result := containers.Get(objectStorageClient, name)
+	container, err := result.Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve OpenStack container %s: %s", name, err)
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve metadata of OpenStack container %s: %s", name, err)
+	}
+
+	listOpts := ObjectStorageObjectListOpts{
+		Prefix:    d.Get("prefix").(string),
+		Delimiter: d.Get("delimiter").(string),
+	}
+
+	allObjects, err := objectStorageObjectV1List(objectStorageClient, name, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to list objects of OpenStack container %s: %s", name, err)
+	}
+
+	objects := []string{}
+	subdirs := []string{}
+	for _, object := range allObjects {
+		if object.Subdir != "" {
+			subdirs = append(subdirs, object.Subdir)
+			continue
+		}
+		objects = append(objects, object.Name)
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack container %s: %+v", name, container)
+	d.SetId(name)
+
+	d.Set("container_read", strings.Join(container.Read, ","))
+	d.Set("container_write", strings.Join(container.Write, ","))
+	d.Set("content_type", container.ContentType)
+	d.Set("versions_location", container.VersionsLocation)
+	d.Set("metadata", metadata)
+	d.Set("object_count", container.ObjectCount)
+	d.Set("bytes_used", container.BytesUsed)
+	d.Set("objects", objects)
+	d.Set("subdirs", subdirs)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackObjectStorageContainerV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObjectStorageV1Container_basic,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackObjectStorageContainerV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageContainerV1DataSourceID("data.openstack_objectstorage_container_v1.container_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "content_type", "application/json"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "metadata.Test", "true"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "object_count", "0"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_container_v1.container_1", "objects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageContainerV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find container data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Container data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackObjectStorageContainerV1DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_objectstorage_container_v1" "container_1" {
+  name = "${openstack_objectstorage_container_v1.container_1.name}"
+}
+`, testAccObjectStorageV1Container_basic)
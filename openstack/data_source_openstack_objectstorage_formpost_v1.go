@@ -0,0 +1,152 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceObjectStorageFormPostV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageFormPostV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"container": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_file_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"max_file_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"signature": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageFormPostV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container").(string)
+	prefix := d.Get("prefix").(string)
+
+	key := d.Get("key").(string)
+	if key == "" {
+		key, err = dataSourceObjectStorageFormPostV1Key(objectStorageClient, container)
+		if err != nil {
+			return err
+		}
+	}
+
+	formURL := objectStorageClient.ServiceURL(container, prefix)
+	u, err := url.Parse(formURL)
+	if err != nil {
+		return fmt.Errorf("Unable to parse the URL of OpenStack container %s: %s", container, err)
+	}
+
+	redirect := d.Get("redirect").(string)
+	maxFileSize := d.Get("max_file_size").(int)
+	maxFileCount := d.Get("max_file_count").(int)
+	expires := time.Now().Add(time.Duration(d.Get("ttl").(int)) * time.Second).Unix()
+
+	signature := objectStorageV1FormPostSignature(key, u.Path, redirect, maxFileSize, maxFileCount, expires)
+
+	log.Printf("[DEBUG] Computed form POST signature for %s expiring at %d", u.Path, expires)
+	d.SetId(strconv.Itoa(hashcode.String(fmt.Sprintf("%s-%d", u.Path, expires))))
+
+	d.Set("url", formURL)
+	d.Set("path", u.Path)
+	d.Set("expires", expires)
+	d.Set("signature", signature)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+// dataSourceObjectStorageFormPostV1Key looks up the key used to sign form
+// POSTs, first in the metadata of the container and then in the one of the
+// account.
+func dataSourceObjectStorageFormPostV1Key(objectStorageClient *gophercloud.ServiceClient, container string) (string, error) {
+	metadata, err := containers.Get(objectStorageClient, container).ExtractMetadata()
+	if err != nil {
+		return "", fmt.Errorf("Unable to retrieve metadata of OpenStack container %s: %s", container, err)
+	}
+	if key := dataSourceObjectStorageFormPostV1MetadataKey(metadata); key != "" {
+		return key, nil
+	}
+
+	metadata, err = objectStorageV1AccountMetadata(objectStorageClient)
+	if err != nil {
+		return "", fmt.Errorf("Unable to retrieve metadata of OpenStack object storage account: %s", err)
+	}
+	if key := dataSourceObjectStorageFormPostV1MetadataKey(metadata); key != "" {
+		return key, nil
+	}
+
+	return "", fmt.Errorf("No key was given and neither container %s nor its account "+
+		"has a Temp-URL-Key set", container)
+}
+
+func dataSourceObjectStorageFormPostV1MetadataKey(metadata map[string]string) string {
+	for k, v := range metadata {
+		if strings.EqualFold(k, "Temp-Url-Key") && v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,65 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackObjectStorageFormPostV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackObjectStorageFormPostV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageFormPostV1DataSourceID("data.openstack_objectstorage_formpost_v1.formpost_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_formpost_v1.formpost_1", "max_file_count", "1"),
+					resource.TestMatchResourceAttr(
+						"data.openstack_objectstorage_formpost_v1.formpost_1", "path", regexp.MustCompile("/container_1/uploads/$")),
+					resource.TestMatchResourceAttr(
+						"data.openstack_objectstorage_formpost_v1.formpost_1", "signature", regexp.MustCompile("^[0-9a-f]{40}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageFormPostV1DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find form POST data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Form POST data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackObjectStorageFormPostV1DataSource_basic = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  metadata {
+    Temp-URL-Key = "secret"
+  }
+}
+
+data "openstack_objectstorage_formpost_v1" "formpost_1" {
+  container = "${openstack_objectstorage_container_v1.container_1.name}"
+  prefix = "uploads/"
+  redirect = "https://example.com/done"
+  max_file_size = 104857600
+  ttl = 3600
+}
+`
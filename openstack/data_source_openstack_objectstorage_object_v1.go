@@ -0,0 +1,83 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceObjectStorageObjectV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageObjectV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"container_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"etag": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	containerName := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	content, header, err := objectStorageObjectV1Get(objectStorageClient, containerName, name)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve OpenStack object %s/%s: %s", containerName, name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack object %s/%s: %d bytes", containerName, name, len(content))
+	d.SetId(fmt.Sprintf("%s/%s", containerName, name))
+
+	d.Set("content", string(content))
+	d.Set("content_type", header.Get("Content-Type"))
+	d.Set("content_length", len(content))
+	d.Set("etag", header.Get("Etag"))
+	d.Set("last_modified", header.Get("Last-Modified"))
+	d.Set("metadata", objectStorageV1Metadata(header, "X-Object-Meta-"))
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
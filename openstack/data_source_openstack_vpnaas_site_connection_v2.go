@@ -0,0 +1,153 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceVPNaaSSiteConnectionV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVPNaaSSiteConnectionV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"connection_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"vpnservice_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"peer_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_cidrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"route_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mtu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"initiator": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dpd": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"interval": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timeout": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPNaaSSiteConnectionV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := VPNaaSSiteConnectionListOpts{
+		ID:           d.Get("connection_id").(string),
+		Name:         d.Get("name").(string),
+		TenantID:     d.Get("tenant_id").(string),
+		VPNServiceID: d.Get("vpnservice_id").(string),
+	}
+
+	connections, err := vpnaasSiteConnectionV2List(networkingClient, listOpts)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve IPsec site connections: %s", err)
+	}
+
+	if len(connections) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(connections) > 1 {
+		return fmt.Errorf("Your query returned more than one result." +
+			" Please try a more specific search criteria")
+	}
+
+	connection := connections[0]
+
+	log.Printf("[DEBUG] Retrieved IPsec site connection %s: %+v", connection.ID, connection)
+	d.SetId(connection.ID)
+
+	dpd := []map[string]interface{}{
+		{
+			"action":   connection.DPD.Action,
+			"interval": connection.DPD.Interval,
+			"timeout":  connection.DPD.Timeout,
+		},
+	}
+
+	d.Set("name", connection.Name)
+	d.Set("tenant_id", connection.TenantID)
+	d.Set("vpnservice_id", connection.VPNServiceID)
+	d.Set("status", connection.Status)
+	d.Set("admin_state_up", connection.AdminStateUp)
+	d.Set("peer_address", connection.PeerAddress)
+	d.Set("peer_id", connection.PeerID)
+	d.Set("peer_cidrs", connection.PeerCIDRs)
+	d.Set("route_mode", connection.RouteMode)
+	d.Set("mtu", connection.MTU)
+	d.Set("initiator", connection.Initiator)
+	d.Set("dpd", dpd)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
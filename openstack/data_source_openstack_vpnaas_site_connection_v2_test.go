@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackVPNaaSSiteConnectionV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVPNSiteConnection(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackVPNaaSSiteConnectionV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNaaSSiteConnectionV2DataSourceID("data.openstack_vpnaas_site_connection_v2.conn_1"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_vpnaas_site_connection_v2.conn_1", "status"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_vpnaas_site_connection_v2.conn_1", "peer_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPNaaSSiteConnectionV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find site connection data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Site connection data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+var testAccOpenStackVPNaaSSiteConnectionV2DataSource_basic = fmt.Sprintf(`
+data "openstack_vpnaas_site_connection_v2" "conn_1" {
+  connection_id = "%s"
+}
+`, OS_VPN_SITE_CONNECTION_ID)
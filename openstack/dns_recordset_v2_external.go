@@ -0,0 +1,145 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Record sets can be shared with other tools managing the same zone, such as
+// Kubernetes external-dns. external-dns marks the names it owns with a TXT
+// record set holding a "heritage=external-dns" marker, named after the record
+// set with an optional prefix and, in recent versions, its type.
+
+// dnsRecordSetV2External describes how to detect the record sets managed
+// outside of Terraform.
+type dnsRecordSetV2External struct {
+	Marker    string
+	TXTPrefix string
+}
+
+func resourceDNSRecordSetV2ExternalSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"marker": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "heritage=external-dns",
+				},
+				"txt_prefix": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceDNSRecordSetV2ExternalOpts(d *schema.ResourceData) (dnsRecordSetV2External, bool) {
+	var opts dnsRecordSetV2External
+
+	raw := d.Get("ignore_records_managed_externally").([]interface{})
+	if len(raw) == 0 {
+		return opts, false
+	}
+
+	// A block without arguments is read as nil.
+	opts.Marker = "heritage=external-dns"
+	if raw[0] != nil {
+		rawMap := raw[0].(map[string]interface{})
+		opts.Marker = rawMap["marker"].(string)
+		opts.TXTPrefix = rawMap["txt_prefix"].(string)
+	}
+
+	return opts, true
+}
+
+// dnsRecordSetV2ManagedExternally tells whether the record set with the given
+// name and type is managed outside of Terraform: either the TXT record set
+// marking its owner has the marker, or its description does.
+func dnsRecordSetV2ManagedExternally(dnsClient *gophercloud.ServiceClient, zoneID, name, recordType, description string, opts dnsRecordSetV2External) (bool, error) {
+	if opts.Marker == "" {
+		return false, nil
+	}
+
+	if strings.Contains(description, opts.Marker) {
+		return true, nil
+	}
+
+	ownerNames := []string{opts.TXTPrefix + name}
+	if recordType != "" {
+		ownerNames = append(ownerNames, opts.TXTPrefix+strings.ToLower(recordType)+"-"+name)
+	}
+
+	for _, ownerName := range ownerNames {
+		listOpts := recordsets.ListOpts{
+			Name: ownerName,
+			Type: "TXT",
+		}
+
+		allPages, err := recordsets.ListByZone(dnsClient, zoneID, listOpts).AllPages()
+		if err != nil {
+			return false, fmt.Errorf("Unable to list DNS record sets named %s: %s", ownerName, err)
+		}
+
+		allRecordSets, err := recordsets.ExtractRecordSets(allPages)
+		if err != nil {
+			return false, fmt.Errorf("Unable to retrieve DNS record sets named %s: %s", ownerName, err)
+		}
+
+		for _, rs := range allRecordSets {
+			for _, record := range rs.Records {
+				if strings.Contains(record, opts.Marker) {
+					log.Printf("[DEBUG] DNS record set %s is marked as managed externally by %s", name, ownerName)
+					return true, nil
+				}
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// dnsRecordSetV2CheckExternalCreate fails the creation of a record set whose
+// name is already managed outside of Terraform, instead of colliding with it.
+func dnsRecordSetV2CheckExternalCreate(dnsClient *gophercloud.ServiceClient, zoneID, name, recordType string, opts dnsRecordSetV2External) error {
+	listOpts := recordsets.ListOpts{
+		Name: name,
+		Type: recordType,
+	}
+
+	allPages, err := recordsets.ListByZone(dnsClient, zoneID, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list DNS record sets named %s: %s", name, err)
+	}
+
+	allRecordSets, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve DNS record sets named %s: %s", name, err)
+	}
+
+	var description string
+	if len(allRecordSets) > 0 {
+		description = allRecordSets[0].Description
+	}
+
+	external, err := dnsRecordSetV2ManagedExternally(dnsClient, zoneID, name, recordType, description, opts)
+	if err != nil {
+		return err
+	}
+
+	if external {
+		return fmt.Errorf("DNS record set %s %s is managed outside of Terraform, as marked by %q. "+
+			"Remove it from the other tool before managing it with Terraform.", name, recordType, opts.Marker)
+	}
+
+	return nil
+}
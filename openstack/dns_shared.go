@@ -0,0 +1,86 @@
+package openstack
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// dnsZoneV2WaitForStatus waits for a zone to reach one of the target
+// statuses. A zone which goes into a status which is neither pending nor a
+// target, such as ERROR, fails the wait.
+func dnsZoneV2WaitForStatus(dnsClient *gophercloud.ServiceClient, zoneID string, target, pending []string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for DNS Zone (%s) to become %v", zoneID, target)
+	stateConf := &resource.StateChangeConf{
+		Target:     target,
+		Pending:    pending,
+		Refresh:    dnsZoneV2RefreshFunc(dnsClient, zoneID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func dnsZoneV2RefreshFunc(dnsClient *gophercloud.ServiceClient, zoneID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		zone, err := zones.Get(dnsClient, zoneID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return zone, "DELETED", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack DNS Zone (%s) current status: %s", zone.ID, zone.Status)
+		return zone, zone.Status, nil
+	}
+}
+
+// dnsZoneV2ForceDelete deletes a zone with the hard delete header, which
+// removes it even if its backend failed and left it in ERROR. It requires
+// admin privileges.
+func dnsZoneV2ForceDelete(dnsClient *gophercloud.ServiceClient, zoneID string) error {
+	var res map[string]interface{}
+	_, err := dnsClient.Delete(dnsClient.ServiceURL("zones", zoneID), &gophercloud.RequestOpts{
+		OkCodes:      []int{202},
+		JSONResponse: &res,
+		MoreHeaders: map[string]string{
+			"X-Designate-Hard-Delete": "true",
+		},
+	})
+	return err
+}
+
+// dnsZoneV2Nameservers returns the hostnames of the authoritative nameservers
+// of a zone, ordered by priority.
+func dnsZoneV2Nameservers(dnsClient *gophercloud.ServiceClient, zoneID string) ([]string, error) {
+	var res struct {
+		Nameservers []struct {
+			Hostname string `json:"hostname"`
+			Priority int    `json:"priority"`
+		} `json:"nameservers"`
+	}
+	_, err := dnsClient.Get(dnsClient.ServiceURL("zones", zoneID, "nameservers"), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(res.Nameservers, func(i, j int) bool {
+		return res.Nameservers[i].Priority < res.Nameservers[j].Priority
+	})
+
+	hostnames := make([]string, len(res.Nameservers))
+	for i, ns := range res.Nameservers {
+		hostnames[i] = ns.Hostname
+	}
+
+	return hostnames, nil
+}
@@ -0,0 +1,84 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// IdentityRegion is a Keystone region of the service catalog.
+type IdentityRegion struct {
+	ID             string `json:"id"`
+	Description    string `json:"description"`
+	ParentRegionID string `json:"parent_region_id"`
+}
+
+// IdentityRegionCreateOpts represents the attributes used when creating a
+// region. Keystone generates the ID if it is empty.
+type IdentityRegionCreateOpts struct {
+	ID             string `json:"id,omitempty"`
+	Description    string `json:"description,omitempty"`
+	ParentRegionID string `json:"parent_region_id,omitempty"`
+}
+
+// IdentityRegionUpdateOpts represents the attributes used when updating a
+// region. An empty ParentRegionID makes the region a top-level one.
+type IdentityRegionUpdateOpts struct {
+	Description    *string `json:"description,omitempty"`
+	ParentRegionID *string `json:"parent_region_id,omitempty"`
+}
+
+func identityRegionV3Create(client *gophercloud.ServiceClient, opts IdentityRegionCreateOpts) (*IdentityRegion, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "region")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Region IdentityRegion `json:"region"`
+	}
+	_, err = client.Post(client.ServiceURL("regions"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Region, nil
+}
+
+func identityRegionV3Get(client *gophercloud.ServiceClient, id string) (*IdentityRegion, error) {
+	var res struct {
+		Region IdentityRegion `json:"region"`
+	}
+	_, err := client.Get(client.ServiceURL("regions", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Region, nil
+}
+
+func identityRegionV3Update(client *gophercloud.ServiceClient, id string, opts IdentityRegionUpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "region")
+	if err != nil {
+		return err
+	}
+
+	// Keystone expects a null parent_region_id to make the region a
+	// top-level one, not an empty string.
+	if opts.ParentRegionID != nil && *opts.ParentRegionID == "" {
+		b["region"].(map[string]interface{})["parent_region_id"] = nil
+	}
+
+	var res struct {
+		Region IdentityRegion `json:"region"`
+	}
+	_, err = client.Patch(client.ServiceURL("regions", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func identityRegionV3Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("regions", id), nil)
+	return err
+}
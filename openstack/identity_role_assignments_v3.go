@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// IdentityRoleAssignment is a Keystone role assignment. Only one of User and
+// Group, and only one of the project and domain of the Scope, are set.
+type IdentityRoleAssignment struct {
+	Role  identityRoleAssignmentRef   `json:"role"`
+	User  identityRoleAssignmentRef   `json:"user"`
+	Group identityRoleAssignmentRef   `json:"group"`
+	Scope identityRoleAssignmentScope `json:"scope"`
+}
+
+type identityRoleAssignmentRef struct {
+	ID string `json:"id"`
+}
+
+type identityRoleAssignmentScope struct {
+	Project     identityRoleAssignmentRef `json:"project"`
+	Domain      identityRoleAssignmentRef `json:"domain"`
+	InheritedTo string                    `json:"OS-INHERIT:inherited_to"`
+}
+
+type IdentityRoleAssignmentListOpts struct {
+	UserID    string `q:"user.id"`
+	GroupID   string `q:"group.id"`
+	ProjectID string `q:"scope.project.id"`
+	DomainID  string `q:"scope.domain.id"`
+	RoleID    string `q:"role.id"`
+	Effective bool   `q:"effective"`
+}
+
+func identityRoleAssignmentV3List(client *gophercloud.ServiceClient, opts IdentityRoleAssignmentListOpts) ([]IdentityRoleAssignment, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		RoleAssignments []IdentityRoleAssignment `json:"role_assignments"`
+	}
+	_, err = client.Get(client.ServiceURL("role_assignments")+q.String(), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.RoleAssignments, nil
+}
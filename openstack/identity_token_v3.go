@@ -0,0 +1,56 @@
+package openstack
+
+import (
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+// identityV3Token is the user and project the provider is authenticated as.
+type identityV3Token struct {
+	Token struct {
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	} `json:"token"`
+}
+
+func identityV3TokenGet(identityClient *gophercloud.ServiceClient) (*identityV3Token, error) {
+	var s identityV3Token
+	if err := tokens.Get(identityClient, identityClient.TokenID).ExtractInto(&s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// identityV3TokenProjectID returns the project the provider is authenticated
+// to, or an empty string if it can't be found.
+func identityV3TokenProjectID(config *Config, region string) string {
+	identityClient, err := config.identityV3Client(region)
+	if err != nil {
+		return ""
+	}
+
+	token, err := identityV3TokenGet(identityClient)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve the project of the token: %s", err)
+		return ""
+	}
+
+	return token.Token.Project.ID
+}
+
+// identityV3TokenUserID returns the user the provider is authenticated as.
+func identityV3TokenUserID(identityClient *gophercloud.ServiceClient) (string, error) {
+	token, err := identityV3TokenGet(identityClient)
+	if err != nil {
+		return "", err
+	}
+
+	return token.Token.User.ID, nil
+}
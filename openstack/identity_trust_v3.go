@@ -0,0 +1,74 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// IdentityTrust is a Keystone trust, which delegates roles of the trustor
+// on a project to the trustee.
+type IdentityTrust struct {
+	ID                 string              `json:"id"`
+	TrustorUserID      string              `json:"trustor_user_id"`
+	TrusteeUserID      string              `json:"trustee_user_id"`
+	ProjectID          string              `json:"project_id"`
+	Impersonation      bool                `json:"impersonation"`
+	ExpiresAt          string              `json:"expires_at"`
+	Roles              []IdentityTrustRole `json:"roles"`
+	RemainingUses      *int                `json:"remaining_uses"`
+	RedelegationCount  int                 `json:"redelegation_count"`
+	RedelegatedTrustID string              `json:"redelegated_trust_id"`
+}
+
+// IdentityTrustRole is a role delegated by a trust, given either by ID or by
+// name.
+type IdentityTrustRole struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// IdentityTrustCreateOpts represents the attributes used when creating a
+// trust. Trusts can't be updated.
+type IdentityTrustCreateOpts struct {
+	TrustorUserID string              `json:"trustor_user_id" required:"true"`
+	TrusteeUserID string              `json:"trustee_user_id" required:"true"`
+	ProjectID     string              `json:"project_id,omitempty"`
+	Impersonation bool                `json:"impersonation"`
+	ExpiresAt     string              `json:"expires_at,omitempty"`
+	Roles         []IdentityTrustRole `json:"roles,omitempty"`
+}
+
+func identityTrustV3Create(client *gophercloud.ServiceClient, opts IdentityTrustCreateOpts) (*IdentityTrust, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "trust")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Trust IdentityTrust `json:"trust"`
+	}
+	_, err = client.Post(client.ServiceURL("OS-TRUST", "trusts"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Trust, nil
+}
+
+func identityTrustV3Get(client *gophercloud.ServiceClient, id string) (*IdentityTrust, error) {
+	var res struct {
+		Trust IdentityTrust `json:"trust"`
+	}
+	_, err := client.Get(client.ServiceURL("OS-TRUST", "trusts", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Trust, nil
+}
+
+func identityTrustV3Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("OS-TRUST", "trusts", id), nil)
+	return err
+}
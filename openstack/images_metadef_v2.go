@@ -0,0 +1,173 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ImagesMetadefNamespace is a Glance metadata definition namespace.
+type ImagesMetadefNamespace struct {
+	Namespace                string                                 `json:"namespace"`
+	DisplayName              string                                 `json:"display_name"`
+	Description              string                                 `json:"description"`
+	Visibility               string                                 `json:"visibility"`
+	Protected                bool                                   `json:"protected"`
+	Owner                    string                                 `json:"owner"`
+	ResourceTypeAssociations []ImagesMetadefResourceTypeAssociation `json:"resource_type_associations"`
+}
+
+// ImagesMetadefNamespaceOpts represents the attributes used when creating or
+// updating a namespace.
+type ImagesMetadefNamespaceOpts struct {
+	Namespace   string `json:"namespace" required:"true"`
+	DisplayName string `json:"display_name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Visibility  string `json:"visibility,omitempty"`
+	Protected   *bool  `json:"protected,omitempty"`
+}
+
+// ImagesMetadefResourceTypeAssociation associates a namespace with a type of
+// resource, such as OS::Glance::Image or OS::Nova::Flavor.
+type ImagesMetadefResourceTypeAssociation struct {
+	Name             string `json:"name"`
+	Prefix           string `json:"prefix,omitempty"`
+	PropertiesTarget string `json:"properties_target,omitempty"`
+}
+
+// ImagesMetadefObject is an object of a namespace. Its properties are JSON
+// schemas, keyed by the name of the property.
+type ImagesMetadefObject struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+}
+
+// ImagesMetadefProperty is a property of a namespace.
+type ImagesMetadefProperty struct {
+	Name        string   `json:"name"`
+	Title       string   `json:"title"`
+	Type        string   `json:"type"`
+	Description string   `json:"description,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	ReadOnly    bool     `json:"readonly,omitempty"`
+}
+
+// imagesMetadefNamespaceV2Create creates a namespace. Unlike most other APIs,
+// the metadata definitions API doesn't wrap request and response bodies in an
+// object named after the resource.
+func imagesMetadefNamespaceV2Create(client *gophercloud.ServiceClient, opts ImagesMetadefNamespaceOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	var res ImagesMetadefNamespace
+	_, err = client.Post(client.ServiceURL("metadefs", "namespaces"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func imagesMetadefNamespaceV2Get(client *gophercloud.ServiceClient, namespace string) (*ImagesMetadefNamespace, error) {
+	var res ImagesMetadefNamespace
+	_, err := client.Get(client.ServiceURL("metadefs", "namespaces", namespace), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func imagesMetadefNamespaceV2Update(client *gophercloud.ServiceClient, namespace string, opts ImagesMetadefNamespaceOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return err
+	}
+
+	var res ImagesMetadefNamespace
+	_, err = client.Put(client.ServiceURL("metadefs", "namespaces", namespace), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func imagesMetadefNamespaceV2Delete(client *gophercloud.ServiceClient, namespace string) error {
+	_, err := client.Delete(client.ServiceURL("metadefs", "namespaces", namespace), nil)
+	return err
+}
+
+func imagesMetadefResourceTypeAssociationV2Create(client *gophercloud.ServiceClient, namespace string, association ImagesMetadefResourceTypeAssociation) error {
+	var res ImagesMetadefResourceTypeAssociation
+	_, err := client.Post(client.ServiceURL("metadefs", "namespaces", namespace, "resource_types"), association, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func imagesMetadefResourceTypeAssociationV2Delete(client *gophercloud.ServiceClient, namespace, name string) error {
+	_, err := client.Delete(client.ServiceURL("metadefs", "namespaces", namespace, "resource_types", name), nil)
+	return err
+}
+
+func imagesMetadefObjectV2Create(client *gophercloud.ServiceClient, namespace string, object ImagesMetadefObject) error {
+	var res ImagesMetadefObject
+	_, err := client.Post(client.ServiceURL("metadefs", "namespaces", namespace, "objects"), object, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func imagesMetadefObjectV2Get(client *gophercloud.ServiceClient, namespace, name string) (*ImagesMetadefObject, error) {
+	var res ImagesMetadefObject
+	_, err := client.Get(client.ServiceURL("metadefs", "namespaces", namespace, "objects", name), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func imagesMetadefObjectV2Update(client *gophercloud.ServiceClient, namespace string, object ImagesMetadefObject) error {
+	var res ImagesMetadefObject
+	_, err := client.Put(client.ServiceURL("metadefs", "namespaces", namespace, "objects", object.Name), object, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func imagesMetadefObjectV2Delete(client *gophercloud.ServiceClient, namespace, name string) error {
+	_, err := client.Delete(client.ServiceURL("metadefs", "namespaces", namespace, "objects", name), nil)
+	return err
+}
+
+func imagesMetadefPropertyV2Create(client *gophercloud.ServiceClient, namespace string, property ImagesMetadefProperty) error {
+	var res ImagesMetadefProperty
+	_, err := client.Post(client.ServiceURL("metadefs", "namespaces", namespace, "properties"), property, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return err
+}
+
+func imagesMetadefPropertyV2Get(client *gophercloud.ServiceClient, namespace, name string) (*ImagesMetadefProperty, error) {
+	var res ImagesMetadefProperty
+	_, err := client.Get(client.ServiceURL("metadefs", "namespaces", namespace, "properties", name), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func imagesMetadefPropertyV2Update(client *gophercloud.ServiceClient, namespace string, property ImagesMetadefProperty) error {
+	var res ImagesMetadefProperty
+	_, err := client.Put(client.ServiceURL("metadefs", "namespaces", namespace, "properties", property.Name), property, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func imagesMetadefPropertyV2Delete(client *gophercloud.ServiceClient, namespace, name string) error {
+	_, err := client.Delete(client.ServiceURL("metadefs", "namespaces", namespace, "properties", name), nil)
+	return err
+}
@@ -22,6 +22,9 @@ func TestAccComputeV2VolumeAttach_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"multiattach",
+				},
 			},
 		},
 	})
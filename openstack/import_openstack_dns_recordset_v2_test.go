@@ -26,3 +26,25 @@ func TestAccDNSV2RecordSet_importBasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDNSV2RecordSet_importByName(t *testing.T) {
+	zoneName := randomZoneName()
+	resourceName := "openstack_dns_recordset_v2.recordset_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNSRecordSetV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2RecordSet_basic(zoneName),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     zoneName + "/" + zoneName + "/a",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
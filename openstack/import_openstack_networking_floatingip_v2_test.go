@@ -22,6 +22,10 @@ func TestAccNetworkingV2FloatingIP_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"prevent_destroy_if_associated",
+					"ignore_default_tags",
+				},
 			},
 		},
 	})
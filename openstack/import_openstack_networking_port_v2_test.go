@@ -24,6 +24,7 @@ func TestAccNetworkingV2Port_importBasic(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"fixed_ip",
+					"ignore_default_tags",
 				},
 			},
 		},
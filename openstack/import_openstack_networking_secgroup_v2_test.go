@@ -22,6 +22,9 @@ func TestAccNetworkingV2SecGroup_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ignore_default_tags",
+				},
 			},
 		},
 	})
@@ -22,6 +22,9 @@ func TestAccNetworkingV2Subnet_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ignore_default_tags",
+				},
 			},
 		},
 	})
@@ -0,0 +1,106 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// InfraOptimAuditTemplate is a Watcher audit template.
+type InfraOptimAuditTemplate struct {
+	UUID        string        `json:"uuid,omitempty"`
+	Name        string        `json:"name"`
+	Description string        `json:"description,omitempty"`
+	Goal        string        `json:"goal"`
+	Strategy    string        `json:"strategy,omitempty"`
+	Scope       []interface{} `json:"scope,omitempty"`
+
+	// Watcher accepts the goal and strategy either by UUID or by name, and
+	// returns both.
+	GoalUUID     string `json:"goal_uuid,omitempty"`
+	GoalName     string `json:"goal_name,omitempty"`
+	StrategyUUID string `json:"strategy_uuid,omitempty"`
+	StrategyName string `json:"strategy_name,omitempty"`
+}
+
+// InfraOptimAudit is a Watcher audit.
+type InfraOptimAudit struct {
+	UUID              string                 `json:"uuid,omitempty"`
+	Name              string                 `json:"name,omitempty"`
+	AuditTemplateUUID string                 `json:"audit_template_uuid,omitempty"`
+	AuditType         string                 `json:"audit_type"`
+	Interval          string                 `json:"interval,omitempty"`
+	Parameters        map[string]interface{} `json:"parameters,omitempty"`
+	AutoTrigger       bool                   `json:"auto_trigger"`
+	State             string                 `json:"state,omitempty"`
+	GoalName          string                 `json:"goal_name,omitempty"`
+	StrategyName      string                 `json:"strategy_name,omitempty"`
+}
+
+func infraOptimAuditTemplateV1Create(client *gophercloud.ServiceClient, template InfraOptimAuditTemplate) (*InfraOptimAuditTemplate, error) {
+	var res InfraOptimAuditTemplate
+	_, err := client.Post(client.ServiceURL("audit_templates"), template, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func infraOptimAuditTemplateV1Get(client *gophercloud.ServiceClient, id string) (*InfraOptimAuditTemplate, error) {
+	var res InfraOptimAuditTemplate
+	_, err := client.Get(client.ServiceURL("audit_templates", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func infraOptimAuditTemplateV1Update(client *gophercloud.ServiceClient, id string, patches []JSONPatch) error {
+	var res InfraOptimAuditTemplate
+	_, err := client.Patch(client.ServiceURL("audit_templates", id), patches, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func infraOptimAuditTemplateV1Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("audit_templates", id), nil)
+	return err
+}
+
+func infraOptimAuditV1Create(client *gophercloud.ServiceClient, audit InfraOptimAudit) (*InfraOptimAudit, error) {
+	var res InfraOptimAudit
+	_, err := client.Post(client.ServiceURL("audits"), audit, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func infraOptimAuditV1Get(client *gophercloud.ServiceClient, id string) (*InfraOptimAudit, error) {
+	var res InfraOptimAudit
+	_, err := client.Get(client.ServiceURL("audits", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func infraOptimAuditV1Update(client *gophercloud.ServiceClient, id string, patches []JSONPatch) error {
+	var res InfraOptimAudit
+	_, err := client.Patch(client.ServiceURL("audits", id), patches, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func infraOptimAuditV1Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("audits", id), nil)
+	return err
+}
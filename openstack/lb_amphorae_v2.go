@@ -0,0 +1,62 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// LBAmphora is an Octavia amphora.
+type LBAmphora struct {
+	ID             string `json:"id"`
+	LoadBalancerID string `json:"loadbalancer_id"`
+	ComputeID      string `json:"compute_id"`
+	Role           string `json:"role"`
+	Status         string `json:"status"`
+	LBNetworkIP    string `json:"lb_network_ip"`
+	HAIP           string `json:"ha_ip"`
+	VRRPIP         string `json:"vrrp_ip"`
+	ImageID        string `json:"image_id"`
+	CertExpiration string `json:"cert_expiration"`
+}
+
+func lbAmphoraV2List(client *gophercloud.ServiceClient, lbID string) ([]LBAmphora, error) {
+	q, err := gophercloud.BuildQueryString(struct {
+		LoadBalancerID string `q:"loadbalancer_id"`
+	}{lbID})
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Amphorae []LBAmphora `json:"amphorae"`
+	}
+	_, err = client.Get(client.ServiceURL("octavia", "amphorae")+q.String(), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Amphorae, nil
+}
+
+func lbAmphoraV2Failover(client *gophercloud.ServiceClient, id string) error {
+	resp, err := client.Put(client.ServiceURL("octavia", "amphorae", id, "failover"), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+	return nil
+}
+
+func lbLoadBalancerV2Failover(client *gophercloud.ServiceClient, lbID string) error {
+	resp, err := client.Put(client.ServiceURL("lbaas", "loadbalancers", lbID, "failover"), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+	return nil
+}
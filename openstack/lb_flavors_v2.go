@@ -0,0 +1,170 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// LBFlavorProfile is an Octavia flavor profile.
+type LBFlavorProfile struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ProviderName string `json:"provider_name"`
+	FlavorData   string `json:"flavor_data"`
+}
+
+// LBFlavorProfileOpts represents the attributes used when creating or
+// updating a flavor profile.
+type LBFlavorProfileOpts struct {
+	Name         string `json:"name,omitempty"`
+	ProviderName string `json:"provider_name,omitempty"`
+	FlavorData   string `json:"flavor_data,omitempty"`
+}
+
+// LBFlavor is an Octavia flavor.
+type LBFlavor struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	FlavorProfileID string `json:"flavor_profile_id"`
+	Enabled         bool   `json:"enabled"`
+}
+
+// LBFlavorCreateOpts represents the attributes used when creating a flavor.
+type LBFlavorCreateOpts struct {
+	Name            string `json:"name" required:"true"`
+	Description     string `json:"description,omitempty"`
+	FlavorProfileID string `json:"flavor_profile_id" required:"true"`
+	Enabled         *bool  `json:"enabled,omitempty"`
+}
+
+// LBFlavorUpdateOpts represents the attributes used when updating a flavor.
+type LBFlavorUpdateOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// LBFlavorListOpts represents the query parameters used when listing flavors.
+type LBFlavorListOpts struct {
+	ID   string `q:"id"`
+	Name string `q:"name"`
+}
+
+func lbFlavorProfileV2Create(client *gophercloud.ServiceClient, opts LBFlavorProfileOpts) (*LBFlavorProfile, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavorprofile")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		FlavorProfile LBFlavorProfile `json:"flavorprofile"`
+	}
+	_, err = client.Post(client.ServiceURL("lbaas", "flavorprofiles"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.FlavorProfile, nil
+}
+
+func lbFlavorProfileV2Get(client *gophercloud.ServiceClient, id string) (*LBFlavorProfile, error) {
+	var res struct {
+		FlavorProfile LBFlavorProfile `json:"flavorprofile"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "flavorprofiles", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.FlavorProfile, nil
+}
+
+func lbFlavorProfileV2Update(client *gophercloud.ServiceClient, id string, opts LBFlavorProfileOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "flavorprofile")
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		FlavorProfile LBFlavorProfile `json:"flavorprofile"`
+	}
+	_, err = client.Put(client.ServiceURL("lbaas", "flavorprofiles", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func lbFlavorProfileV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "flavorprofiles", id), nil)
+	return err
+}
+
+func lbFlavorV2Create(client *gophercloud.ServiceClient, opts LBFlavorCreateOpts) (*LBFlavor, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "flavor")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Flavor LBFlavor `json:"flavor"`
+	}
+	_, err = client.Post(client.ServiceURL("lbaas", "flavors"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Flavor, nil
+}
+
+func lbFlavorV2Get(client *gophercloud.ServiceClient, id string) (*LBFlavor, error) {
+	var res struct {
+		Flavor LBFlavor `json:"flavor"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "flavors", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Flavor, nil
+}
+
+func lbFlavorV2List(client *gophercloud.ServiceClient, opts LBFlavorListOpts) ([]LBFlavor, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Flavors []LBFlavor `json:"flavors"`
+	}
+	_, err = client.Get(client.ServiceURL("lbaas", "flavors")+q.String(), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Flavors, nil
+}
+
+func lbFlavorV2Update(client *gophercloud.ServiceClient, id string, opts LBFlavorUpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "flavor")
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		Flavor LBFlavor `json:"flavor"`
+	}
+	_, err = client.Put(client.ServiceURL("lbaas", "flavors", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func lbFlavorV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "flavors", id), nil)
+	return err
+}
@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// LBMemberBatchOpts represents a member of a pool in a batch member update.
+type LBMemberBatchOpts struct {
+	Name         string `json:"name,omitempty"`
+	Address      string `json:"address"`
+	ProtocolPort int    `json:"protocol_port"`
+	Weight       int    `json:"weight,omitempty"`
+	SubnetID     string `json:"subnet_id,omitempty"`
+	AdminStateUp *bool  `json:"admin_state_up,omitempty"`
+}
+
+// lbMembersV2BatchUpdate replaces all the members of a pool in a single
+// request. Octavia matches the members on their address and protocol port:
+// it creates the new ones, updates the existing ones and deletes the others.
+func lbMembersV2BatchUpdate(client *gophercloud.ServiceClient, poolID string, members []LBMemberBatchOpts) error {
+	if members == nil {
+		members = []LBMemberBatchOpts{}
+	}
+
+	b := map[string]interface{}{"members": members}
+	resp, err := client.Put(client.ServiceURL("lbaas", "pools", poolID, "members"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+	return nil
+}
+
+func lbMembersV2List(client *gophercloud.ServiceClient, poolID string) ([]pools.Member, error) {
+	var members []pools.Member
+	err := pools.ListMembers(client, poolID, pools.ListMembersOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		pageMembers, err := pools.ExtractMembers(page)
+		if err != nil {
+			return false, err
+		}
+
+		members = append(members, pageMembers...)
+		return true, nil
+	})
+
+	return members, err
+}
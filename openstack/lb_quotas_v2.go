@@ -0,0 +1,120 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// LBQuota is the Octavia quota of a project.
+type LBQuota struct {
+	LoadBalancer  int `json:"load_balancer"`
+	Listener      int `json:"listener"`
+	Member        int `json:"member"`
+	Pool          int `json:"pool"`
+	HealthMonitor int `json:"health_monitor"`
+}
+
+// LBQuotaUpdateOpts represents the attributes used when updating a quota.
+type LBQuotaUpdateOpts struct {
+	LoadBalancer  *int `json:"load_balancer,omitempty"`
+	Listener      *int `json:"listener,omitempty"`
+	Member        *int `json:"member,omitempty"`
+	Pool          *int `json:"pool,omitempty"`
+	HealthMonitor *int `json:"health_monitor,omitempty"`
+}
+
+func lbQuotaV2Get(client *gophercloud.ServiceClient, projectID string) (*LBQuota, error) {
+	var res struct {
+		Quota LBQuota `json:"quota"`
+	}
+	_, err := client.Get(client.ServiceURL("lbaas", "quotas", projectID), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Quota, nil
+}
+
+func lbQuotaV2Update(client *gophercloud.ServiceClient, projectID string, opts LBQuotaUpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "quota")
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		Quota LBQuota `json:"quota"`
+	}
+	_, err = client.Put(client.ServiceURL("lbaas", "quotas", projectID), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return err
+}
+
+// lbQuotaV2Delete resets the quota of a project to the defaults.
+func lbQuotaV2Delete(client *gophercloud.ServiceClient, projectID string) error {
+	_, err := client.Delete(client.ServiceURL("lbaas", "quotas", projectID), nil)
+	return err
+}
+
+// lbQuotaV2IsExceeded tells whether an error is a quota error. Octavia
+// returns them as 403 "Quota has been met", and Neutron LBaaS as 409
+// OverQuota, which must not be retried like other conflicts.
+func lbQuotaV2IsExceeded(err error) bool {
+	errCode, ok := err.(gophercloud.ErrUnexpectedResponseCode)
+	if !ok {
+		return false
+	}
+
+	body := strings.ToLower(string(errCode.Body))
+	switch errCode.Actual {
+	case 403:
+		return strings.Contains(body, "quota has been met")
+	case 409:
+		return strings.Contains(body, "overquota") || strings.Contains(body, "quota exceeded")
+	}
+
+	return false
+}
+
+// lbQuotaV2ExceededError explains a quota error for the given kind of
+// resource, as named in LBQuota, with its limit in the project of the
+// resource.
+func lbQuotaV2ExceededError(d *schema.ResourceData, config *Config, client *gophercloud.ServiceClient, kind string, err error) error {
+	projectID := d.Get("tenant_id").(string)
+	if projectID == "" {
+		projectID = identityV3TokenProjectID(config, GetRegion(d))
+	}
+
+	name := strings.Replace(kind, "_", " ", -1) + "s"
+	if projectID == "" {
+		return fmt.Errorf("Quota exceeded for %s: %s", name, err)
+	}
+
+	limit := -1
+	if quota, qErr := lbQuotaV2Get(client, projectID); qErr != nil {
+		log.Printf("[DEBUG] Unable to retrieve load balancer quota of project %s: %s", projectID, qErr)
+	} else {
+		switch kind {
+		case "load_balancer":
+			limit = quota.LoadBalancer
+		case "listener":
+			limit = quota.Listener
+		case "pool":
+			limit = quota.Pool
+		case "member":
+			limit = quota.Member
+		case "health_monitor":
+			limit = quota.HealthMonitor
+		}
+	}
+
+	if limit < 0 {
+		return fmt.Errorf("Quota exceeded for %s in project %s: %s", name, projectID, err)
+	}
+
+	return fmt.Errorf("Quota exceeded for %s (limit %d) in project %s: %s", name, limit, projectID, err)
+}
@@ -0,0 +1,53 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func TestLBQuotaV2IsExceeded(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{
+			err: gophercloud.ErrUnexpectedResponseCode{
+				Actual: 403,
+				Body:   []byte(`{"faultcode": "Client", "faultstring": "Quota has been met for resources: Listener"}`),
+			},
+			expected: true,
+		},
+		{
+			err: gophercloud.ErrUnexpectedResponseCode{
+				Actual: 409,
+				Body:   []byte(`{"NeutronError": {"type": "OverQuota", "message": "Quota exceeded for resources: ['pool']."}}`),
+			},
+			expected: true,
+		},
+		{
+			err: gophercloud.ErrUnexpectedResponseCode{
+				Actual: 409,
+				Body:   []byte(`{"faultstring": "Load Balancer 8a2f is immutable and cannot be updated."}`),
+			},
+			expected: false,
+		},
+		{
+			err: gophercloud.ErrUnexpectedResponseCode{
+				Actual: 403,
+				Body:   []byte(`{"faultstring": "Policy does not allow this request to be performed."}`),
+			},
+			expected: false,
+		},
+		{
+			err:      gophercloud.ErrDefault500{},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		if actual := lbQuotaV2IsExceeded(c.err); actual != c.expected {
+			t.Fatalf("expected %t for %#v, got %t", c.expected, c.err, actual)
+		}
+	}
+}
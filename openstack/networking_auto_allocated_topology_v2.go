@@ -0,0 +1,30 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// AutoAllocatedTopology is the network Neutron allocated to a project.
+type AutoAllocatedTopology struct {
+	ID       string `json:"id"`
+	TenantID string `json:"tenant_id"`
+}
+
+// networkingAutoAllocatedTopologyV2Get returns the auto-allocated topology of
+// a project, which Neutron creates if the project doesn't have one yet.
+func networkingAutoAllocatedTopologyV2Get(client *gophercloud.ServiceClient, projectID string) (*AutoAllocatedTopology, error) {
+	var res struct {
+		Topology AutoAllocatedTopology `json:"auto_allocated_topology"`
+	}
+	_, err := client.Get(client.ServiceURL("auto-allocated-topology", projectID), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Topology, nil
+}
+
+func networkingAutoAllocatedTopologyV2Delete(client *gophercloud.ServiceClient, projectID string) error {
+	_, err := client.Delete(client.ServiceURL("auto-allocated-topology", projectID), nil)
+	return err
+}
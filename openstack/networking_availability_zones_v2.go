@@ -0,0 +1,42 @@
+package openstack
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NetworkingAvailabilityZone is a Neutron availability zone of a resource
+// type. A zone is listed once per resource type it serves.
+type NetworkingAvailabilityZone struct {
+	Name     string `json:"name"`
+	Resource string `json:"resource"`
+	State    string `json:"state"`
+}
+
+// networkingAvailabilityZonesV2List lists the availability zones, filtered by
+// resource type and state when they are set.
+func networkingAvailabilityZonesV2List(client *gophercloud.ServiceClient, resourceType, state string) ([]NetworkingAvailabilityZone, error) {
+	q := url.Values{}
+	if resourceType != "" {
+		q.Set("resource", resourceType)
+	}
+	if state != "" {
+		q.Set("state", state)
+	}
+
+	u := client.ServiceURL("availability_zones")
+	if len(q) > 0 {
+		u += "?" + q.Encode()
+	}
+
+	var res struct {
+		AvailabilityZones []NetworkingAvailabilityZone `json:"availability_zones"`
+	}
+	_, err := client.Get(u, &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.AvailabilityZones, nil
+}
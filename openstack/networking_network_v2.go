@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+)
+
+// NetworkingNetwork is a network with the attributes of the external-net,
+// tag, auto-allocated-topology and qos extensions, which the vendored
+// gophercloud does not include.
+type NetworkingNetwork struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	AdminStateUp bool     `json:"admin_state_up"`
+	Status       string   `json:"status"`
+	Subnets      []string `json:"subnets"`
+	TenantID     string   `json:"tenant_id"`
+	Shared       bool     `json:"shared"`
+	External     bool     `json:"router:external"`
+	IsDefault    bool     `json:"is_default"`
+	Tags         []string `json:"tags"`
+	QoSPolicyID  string   `json:"qos_policy_id"`
+	MTU          int      `json:"mtu"`
+}
+
+// NetworkingNetworkListOpts adds the router:external and tag filters to
+// networks.ListOpts. Networks match Tags when they have all of them, and
+// TagsAny when they have at least one of them.
+type NetworkingNetworkListOpts struct {
+	networks.ListOpts
+	External *bool
+	Tags     []string
+	TagsAny  []string
+}
+
+// ToNetworkListQuery formats a NetworkingNetworkListOpts into a query string.
+func (opts NetworkingNetworkListOpts) ToNetworkListQuery() (string, error) {
+	q, err := opts.ListOpts.ToNetworkListQuery()
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	if opts.External != nil {
+		params.Set("router:external", strconv.FormatBool(*opts.External))
+	}
+	if len(opts.Tags) > 0 {
+		params.Set("tags", strings.Join(opts.Tags, ","))
+	}
+	if len(opts.TagsAny) > 0 {
+		params.Set("tags-any", strings.Join(opts.TagsAny, ","))
+	}
+
+	if len(params) > 0 {
+		sep := "?"
+		if q != "" {
+			sep = "&"
+		}
+		q = q + sep + params.Encode()
+	}
+
+	return q, nil
+}
+
+func networkingNetworkV2List(client *gophercloud.ServiceClient, opts NetworkingNetworkListOpts) ([]NetworkingNetwork, error) {
+	allPages, err := networks.List(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	var s struct {
+		Networks []NetworkingNetwork `json:"networks"`
+	}
+	if err := allPages.(networks.NetworkPage).ExtractInto(&s); err != nil {
+		return nil, err
+	}
+
+	return s.Networks, nil
+}
+
+// networkingNetworkV2RankExternal breaks ties between external networks.
+// When preferDefault is set, the networks marked as default by the
+// auto-allocated-topology extension come first. Then the networks are ranked
+// by the first tag of tagPriority they have. The best ranked networks are
+// returned, so more than one is returned if the tie can't be broken.
+func networkingNetworkV2RankExternal(candidates []NetworkingNetwork, tagPriority []string, preferDefault bool) []NetworkingNetwork {
+	rank := func(n NetworkingNetwork) int {
+		r := len(tagPriority)
+		for i, priority := range tagPriority {
+			if strSliceContains(n.Tags, priority) {
+				r = i
+				break
+			}
+		}
+
+		if preferDefault && !n.IsDefault {
+			r += len(tagPriority) + 1
+		}
+
+		return r
+	}
+
+	var best []NetworkingNetwork
+	bestRank := -1
+	for _, n := range candidates {
+		r := rank(n)
+		switch {
+		case bestRank == -1 || r < bestRank:
+			best = []NetworkingNetwork{n}
+			bestRank = r
+		case r == bestRank:
+			best = append(best, n)
+		}
+	}
+
+	return best
+}
+
+// networkingNetworkV2External returns the external network to use when none
+// is given, ranked by the external_network_tag_priority and
+// external_network_prefer_default settings of the provider.
+func networkingNetworkV2External(client *gophercloud.ServiceClient, config *Config) (*NetworkingNetwork, error) {
+	external := true
+	candidates, err := networkingNetworkV2List(client, NetworkingNetworkListOpts{
+		ListOpts: networks.ListOpts{Status: "ACTIVE"},
+		External: &external,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve external networks: %s", err)
+	}
+
+	best := networkingNetworkV2RankExternal(candidates, config.ExternalNetworkTagPriority, config.ExternalNetworkPreferDefault)
+	if len(best) < 1 {
+		return nil, fmt.Errorf("No external network was found")
+	}
+
+	if len(best) > 1 {
+		return nil, fmt.Errorf("%d external networks were found and none of them is "+
+			"preferred by external_network_tag_priority or external_network_prefer_default", len(best))
+	}
+
+	log.Printf("[DEBUG] Selected external network %s (%s)", best[0].ID, best[0].Name)
+
+	return &best[0], nil
+}
@@ -0,0 +1,96 @@
+package openstack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+)
+
+func TestNetworkingNetworkV2RankExternal(t *testing.T) {
+	candidates := []NetworkingNetwork{
+		{ID: "public", Tags: []string{"internet"}},
+		{ID: "provider", Tags: []string{"datacenter", "internet"}},
+		{ID: "default", IsDefault: true},
+	}
+
+	cases := []struct {
+		name          string
+		tagPriority   []string
+		preferDefault bool
+		expected      []string
+	}{
+		{
+			name:     "no ranking",
+			expected: []string{"public", "provider", "default"},
+		},
+		{
+			name:        "tag priority",
+			tagPriority: []string{"datacenter", "internet"},
+			expected:    []string{"provider"},
+		},
+		{
+			name:        "tie on tag priority",
+			tagPriority: []string{"internet"},
+			expected:    []string{"public", "provider"},
+		},
+		{
+			name:          "default network",
+			tagPriority:   []string{"internet"},
+			preferDefault: true,
+			expected:      []string{"default"},
+		},
+	}
+
+	for _, c := range cases {
+		var ids []string
+		for _, n := range networkingNetworkV2RankExternal(candidates, c.tagPriority, c.preferDefault) {
+			ids = append(ids, n.ID)
+		}
+
+		if strings.Join(ids, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, ids)
+		}
+	}
+}
+
+func TestNetworkingNetworkV2ListOpts(t *testing.T) {
+	external := true
+	cases := []struct {
+		name     string
+		opts     NetworkingNetworkListOpts
+		expected string
+	}{
+		{
+			name:     "no filters",
+			expected: "",
+		},
+		{
+			name: "name and external",
+			opts: NetworkingNetworkListOpts{
+				ListOpts: networks.ListOpts{Name: "public"},
+				External: &external,
+			},
+			expected: "?name=public&router%3Aexternal=true",
+		},
+		{
+			name: "tags",
+			opts: NetworkingNetworkListOpts{
+				Tags:    []string{"a", "b"},
+				TagsAny: []string{"c"},
+			},
+			expected: "?tags=a%2Cb&tags-any=c",
+		},
+	}
+
+	for _, c := range cases {
+		q, err := c.opts.ToNetworkListQuery()
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+
+		if q != c.expected {
+			t.Errorf("%s: expected %q, got %q", c.name, c.expected, q)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// networkingPortBindingV2VNICTypes are the VNIC types Neutron accepts in the
+// binding:vnic_type of a port.
+var networkingPortBindingV2VNICTypes = []string{
+	"normal", "direct", "direct-physical", "macvtap", "baremetal", "virtio-forwarder",
+}
+
+// NetworkingPortBinding is the binding of a port, which the vendored
+// gophercloud does not include.
+type NetworkingPortBinding struct {
+	HostID     string                 `json:"binding:host_id"`
+	VNICType   string                 `json:"binding:vnic_type"`
+	Profile    map[string]interface{} `json:"binding:profile"`
+	VIFType    string                 `json:"binding:vif_type"`
+	VIFDetails map[string]interface{} `json:"binding:vif_details"`
+}
+
+func resourceNetworkingPortBindingV2Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"vnic_type": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						if !strSliceContains(networkingPortBindingV2VNICTypes, v.(string)) {
+							errors = append(errors, fmt.Errorf("%q must be one of %v", k, networkingPortBindingV2VNICTypes))
+						}
+						return
+					},
+				},
+				"profile": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateNetworkingPortBindingV2Profile,
+					StateFunc:    normalizeNetworkingPortBindingV2Profile,
+				},
+				"vif_type": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"vif_details": &schema.Schema{
+					Type:     schema.TypeMap,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// resourceNetworkingPortBindingV2Opts returns the binding of a port given in
+// its binding block.
+func resourceNetworkingPortBindingV2Opts(d *schema.ResourceData) (PortBindingOpts, error) {
+	var opts PortBindingOpts
+	if _, ok := d.GetOk("binding"); !ok {
+		return opts, nil
+	}
+
+	opts.HostID = d.Get("binding.0.host_id").(string)
+	opts.VNICType = d.Get("binding.0.vnic_type").(string)
+
+	if v := d.Get("binding.0.profile").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &opts.Profile); err != nil {
+			return opts, fmt.Errorf("Error decoding binding profile: %s", err)
+		}
+	}
+
+	return opts, nil
+}
+
+// resourceNetworkingPortBindingV2Update sets the binding of a port update
+// request. The profile is always sent, as an empty one removes it.
+func resourceNetworkingPortBindingV2Update(d *schema.ResourceData, b map[string]interface{}) error {
+	opts, err := resourceNetworkingPortBindingV2Opts(d)
+	if err != nil {
+		return err
+	}
+
+	port := b["port"].(map[string]interface{})
+	if opts.HostID != "" {
+		port["binding:host_id"] = opts.HostID
+	}
+	if opts.VNICType != "" {
+		port["binding:vnic_type"] = opts.VNICType
+	}
+
+	if opts.Profile == nil {
+		opts.Profile = map[string]interface{}{}
+	}
+	port["binding:profile"] = opts.Profile
+
+	return nil
+}
+
+// flattenNetworkingPortBindingV2 converts the binding of a port to the
+// binding block.
+func flattenNetworkingPortBindingV2(binding NetworkingPortBinding) []map[string]interface{} {
+	var profile string
+	if len(binding.Profile) > 0 {
+		b, _ := json.Marshal(binding.Profile)
+		profile = string(b)
+	}
+
+	vifDetails := make(map[string]string, len(binding.VIFDetails))
+	for k, v := range binding.VIFDetails {
+		vifDetails[k] = fmt.Sprintf("%v", v)
+	}
+
+	return []map[string]interface{}{
+		{
+			"host_id":     binding.HostID,
+			"vnic_type":   binding.VNICType,
+			"profile":     profile,
+			"vif_type":    binding.VIFType,
+			"vif_details": vifDetails,
+		},
+	}
+}
+
+func validateNetworkingPortBindingV2Profile(v interface{}, k string) (ws []string, errors []error) {
+	var profile map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &profile); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
+
+// normalizeNetworkingPortBindingV2Profile re-encodes the profile so that
+// whitespace and key order don't cause a diff.
+func normalizeNetworkingPortBindingV2Profile(v interface{}) string {
+	var profile map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &profile); err != nil {
+		return v.(string)
+	}
+
+	if len(profile) == 0 {
+		return ""
+	}
+
+	b, _ := json.Marshal(profile)
+	return string(b)
+}
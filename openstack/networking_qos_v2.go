@@ -0,0 +1,216 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// QoSPolicy is a Neutron QoS policy.
+type QoSPolicy struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	TenantID    string `json:"tenant_id"`
+	Shared      bool   `json:"shared"`
+	IsDefault   bool   `json:"is_default"`
+}
+
+// QoSPolicyCreateOpts represents the attributes used when creating a QoS
+// policy.
+type QoSPolicyCreateOpts struct {
+	Name        string `json:"name" required:"true"`
+	Description string `json:"description,omitempty"`
+	TenantID    string `json:"tenant_id,omitempty"`
+	Shared      bool   `json:"shared,omitempty"`
+	IsDefault   bool   `json:"is_default,omitempty"`
+}
+
+// QoSPolicyUpdateOpts represents the attributes used when updating a QoS
+// policy.
+type QoSPolicyUpdateOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Shared      *bool   `json:"shared,omitempty"`
+	IsDefault   *bool   `json:"is_default,omitempty"`
+}
+
+// QoSRule is a rule of a QoS policy. Only the fields of its type are set.
+type QoSRule struct {
+	ID           string `json:"id"`
+	MaxKBps      int    `json:"max_kbps"`
+	MaxBurstKBps int    `json:"max_burst_kbps"`
+	MinKBps      int    `json:"min_kbps"`
+	DSCPMark     int    `json:"dscp_mark"`
+	Direction    string `json:"direction"`
+}
+
+// QoSBandwidthLimitRuleOpts represents the attributes used when creating or
+// updating a bandwidth limit rule.
+type QoSBandwidthLimitRuleOpts struct {
+	MaxKBps      int    `json:"max_kbps"`
+	MaxBurstKBps int    `json:"max_burst_kbps"`
+	Direction    string `json:"direction,omitempty"`
+}
+
+// QoSDSCPMarkingRuleOpts represents the attributes used when creating or
+// updating a DSCP marking rule.
+type QoSDSCPMarkingRuleOpts struct {
+	DSCPMark int `json:"dscp_mark"`
+}
+
+// QoSMinimumBandwidthRuleOpts represents the attributes used when creating or
+// updating a minimum bandwidth rule.
+type QoSMinimumBandwidthRuleOpts struct {
+	MinKBps   int    `json:"min_kbps"`
+	Direction string `json:"direction,omitempty"`
+}
+
+// The rule types of a QoS policy, as used in the rule URLs and request bodies.
+const (
+	networkingQoSBandwidthLimitRule   = "bandwidth_limit"
+	networkingQoSDSCPMarkingRule      = "dscp_marking"
+	networkingQoSMinimumBandwidthRule = "minimum_bandwidth"
+)
+
+// networkingQoSDSCPMarks are the DSCP marks Neutron accepts in DSCP marking
+// rules.
+var networkingQoSDSCPMarks = map[int]struct{}{
+	0: {}, 8: {}, 10: {}, 12: {}, 14: {}, 16: {}, 18: {}, 20: {}, 22: {},
+	24: {}, 26: {}, 28: {}, 30: {}, 32: {}, 34: {}, 36: {}, 38: {}, 40: {},
+	44: {}, 46: {}, 48: {}, 56: {},
+}
+
+func networkingQoSPolicyV2Create(client *gophercloud.ServiceClient, opts QoSPolicyCreateOpts) (*QoSPolicy, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "policy")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Policy QoSPolicy `json:"policy"`
+	}
+	_, err = client.Post(client.ServiceURL("qos", "policies"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Policy, nil
+}
+
+func networkingQoSPolicyV2Get(client *gophercloud.ServiceClient, id string) (*QoSPolicy, error) {
+	var res struct {
+		Policy QoSPolicy `json:"policy"`
+	}
+	_, err := client.Get(client.ServiceURL("qos", "policies", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Policy, nil
+}
+
+func networkingQoSPolicyV2Update(client *gophercloud.ServiceClient, id string, opts QoSPolicyUpdateOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "policy")
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		Policy QoSPolicy `json:"policy"`
+	}
+	_, err = client.Put(client.ServiceURL("qos", "policies", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func networkingQoSPolicyV2Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL("qos", "policies", id), nil)
+	return err
+}
+
+// networkingQoSRuleV2Create creates a rule of the given type, such as
+// networkingQoSBandwidthLimitRule, in a QoS policy.
+func networkingQoSRuleV2Create(client *gophercloud.ServiceClient, policyID, ruleType string, opts interface{}) (*QoSRule, error) {
+	b, err := gophercloud.BuildRequestBody(opts, ruleType+"_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	var res map[string]QoSRule
+	_, err = client.Post(client.ServiceURL("qos", "policies", policyID, ruleType+"_rules"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rule := res[ruleType+"_rule"]
+	return &rule, nil
+}
+
+func networkingQoSRuleV2Get(client *gophercloud.ServiceClient, policyID, ruleType, id string) (*QoSRule, error) {
+	var res map[string]QoSRule
+	_, err := client.Get(client.ServiceURL("qos", "policies", policyID, ruleType+"_rules", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := res[ruleType+"_rule"]
+	return &rule, nil
+}
+
+func networkingQoSRuleV2Update(client *gophercloud.ServiceClient, policyID, ruleType, id string, opts interface{}) error {
+	b, err := gophercloud.BuildRequestBody(opts, ruleType+"_rule")
+	if err != nil {
+		return err
+	}
+
+	var res map[string]QoSRule
+	_, err = client.Put(client.ServiceURL("qos", "policies", policyID, ruleType+"_rules", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func networkingQoSRuleV2Delete(client *gophercloud.ServiceClient, policyID, ruleType, id string) error {
+	_, err := client.Delete(client.ServiceURL("qos", "policies", policyID, ruleType+"_rules", id), nil)
+	return err
+}
+
+// networkingQoSPolicyIDV2Update sets the qos_policy_id of a port or network
+// update request built under key. Neutron only detaches a QoS policy when the
+// ID is null, which the gophercloud update options can't send.
+func networkingQoSPolicyIDV2Update(d *schema.ResourceData, b map[string]interface{}, key string) {
+	var qosPolicyID interface{}
+	if v := d.Get("qos_policy_id").(string); v != "" {
+		qosPolicyID = v
+	}
+
+	b[key].(map[string]interface{})["qos_policy_id"] = qosPolicyID
+}
+
+// parseNetworkingQoSRuleV2ID splits the ID of a QoS rule resource, which is
+// made of the IDs of the policy and the rule since Neutron only addresses
+// rules through their policy.
+func parseNetworkingQoSRuleV2ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 {
+		return "", "", fmt.Errorf("Unable to determine QoS rule ID from raw ID: %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+func validateNetworkingQoSRuleV2Direction(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "egress" && value != "ingress" {
+		errors = append(errors, fmt.Errorf("Only 'egress' and 'ingress' are supported values for %q", k))
+	}
+	return
+}
@@ -0,0 +1,40 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// networkingV2RevisionNumber returns the revision_number an update of a
+// Neutron resource must match, or 0 when neutron_revision_check is disabled.
+func networkingV2RevisionNumber(d *schema.ResourceData, config *Config) int {
+	if !config.NeutronRevisionCheck {
+		return 0
+	}
+	return d.Get("revision_number").(int)
+}
+
+// networkingV2Update updates a Neutron resource. When revisionNumber is set,
+// the request carries an If-Match header so that Neutron rejects the update
+// if the resource was modified since Terraform last read it.
+func networkingV2Update(client *gophercloud.ServiceClient, url string, body map[string]interface{}, revisionNumber int) error {
+	reqOpts := &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	}
+	if revisionNumber > 0 {
+		reqOpts.MoreHeaders = map[string]string{
+			"If-Match": fmt.Sprintf("revision_number=%d", revisionNumber),
+		}
+	}
+
+	var res map[string]interface{}
+	_, err := client.Put(url, body, &res, reqOpts)
+	if errCode, ok := err.(gophercloud.ErrUnexpectedResponseCode); ok && errCode.Actual == 412 {
+		return fmt.Errorf("the resource was modified since revision %d was read. "+
+			"Run terraform plan again to review the changes", revisionNumber)
+	}
+
+	return err
+}
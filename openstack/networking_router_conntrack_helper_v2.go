@@ -0,0 +1,88 @@
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// RouterConntrackHelper is a conntrack helper of a router, which enables an
+// ALG such as FTP or TFTP for the traffic to a port.
+type RouterConntrackHelper struct {
+	ID       string `json:"id"`
+	Protocol string `json:"protocol"`
+	Port     int    `json:"port"`
+	Helper   string `json:"helper"`
+}
+
+// RouterConntrackHelperOpts represents the attributes used when creating or
+// updating a conntrack helper.
+type RouterConntrackHelperOpts struct {
+	Protocol string `json:"protocol"`
+	Port     int    `json:"port"`
+	Helper   string `json:"helper"`
+}
+
+func networkingRouterConntrackHelperV2Create(client *gophercloud.ServiceClient, routerID string, opts RouterConntrackHelperOpts) (*RouterConntrackHelper, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "conntrack_helper")
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		ConntrackHelper RouterConntrackHelper `json:"conntrack_helper"`
+	}
+	_, err = client.Post(client.ServiceURL("routers", routerID, "conntrack_helpers"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.ConntrackHelper, nil
+}
+
+func networkingRouterConntrackHelperV2Get(client *gophercloud.ServiceClient, routerID, id string) (*RouterConntrackHelper, error) {
+	var res struct {
+		ConntrackHelper RouterConntrackHelper `json:"conntrack_helper"`
+	}
+	_, err := client.Get(client.ServiceURL("routers", routerID, "conntrack_helpers", id), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.ConntrackHelper, nil
+}
+
+func networkingRouterConntrackHelperV2Update(client *gophercloud.ServiceClient, routerID, id string, opts RouterConntrackHelperOpts) error {
+	b, err := gophercloud.BuildRequestBody(opts, "conntrack_helper")
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		ConntrackHelper RouterConntrackHelper `json:"conntrack_helper"`
+	}
+	_, err = client.Put(client.ServiceURL("routers", routerID, "conntrack_helpers", id), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
+func networkingRouterConntrackHelperV2Delete(client *gophercloud.ServiceClient, routerID, id string) error {
+	_, err := client.Delete(client.ServiceURL("routers", routerID, "conntrack_helpers", id), nil)
+	return err
+}
+
+// parseNetworkingRouterConntrackHelperV2ID splits the ID of a conntrack helper
+// resource, which is made of the IDs of the router and the helper since
+// Neutron only addresses helpers through their router.
+func parseNetworkingRouterConntrackHelperV2ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 {
+		return "", "", fmt.Errorf("Unable to determine conntrack helper ID from raw ID: %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
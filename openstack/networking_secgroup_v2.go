@@ -0,0 +1,66 @@
+package openstack
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// NetworkingSecGroup is a security group with the attributes of the tag
+// extension, which the vendored gophercloud does not include.
+type NetworkingSecGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	TenantID    string   `json:"tenant_id"`
+	Tags        []string `json:"tags"`
+}
+
+// NetworkingSecGroupListOpts adds the tag filters to groups.ListOpts.
+// Security groups match Tags when they have all of them, and TagsAny when
+// they have at least one of them.
+type NetworkingSecGroupListOpts struct {
+	groups.ListOpts
+	Tags    []string
+	TagsAny []string
+}
+
+// networkingSecGroupV2List lists security groups. groups.List only accepts
+// a groups.ListOpts, so the request is made here to add the tag filters.
+func networkingSecGroupV2List(client *gophercloud.ServiceClient, opts NetworkingSecGroupListOpts) ([]NetworkingSecGroup, error) {
+	q, err := gophercloud.BuildQueryString(&opts.ListOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	params := q.Query()
+	if len(opts.Tags) > 0 {
+		params.Set("tags", strings.Join(opts.Tags, ","))
+	}
+	if len(opts.TagsAny) > 0 {
+		params.Set("tags-any", strings.Join(opts.TagsAny, ","))
+	}
+
+	u := client.ServiceURL("security-groups")
+	if len(params) > 0 {
+		u = u + "?" + params.Encode()
+	}
+
+	allPages, err := pagination.NewPager(client, u, func(r pagination.PageResult) pagination.Page {
+		return groups.SecGroupPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	var s struct {
+		SecGroups []NetworkingSecGroup `json:"security_groups"`
+	}
+	if err := allPages.(groups.SecGroupPage).ExtractInto(&s); err != nil {
+		return nil, err
+	}
+
+	return s.SecGroups, nil
+}
@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// networkingV2AttributesTags returns the tags of a resource, sorted.
+func networkingV2AttributesTags(d *schema.ResourceData) []string {
+	return networkingV2SortedTags(d.Get("tags").(*schema.Set))
+}
+
+func networkingV2SortedTags(set *schema.Set) []string {
+	tags := []string{}
+	for _, v := range set.List() {
+		tags = append(tags, v.(string))
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+// networkingV2ReadAttributesTags sets all_tags to the tags of a resource, and
+// tags to those which are also in its configuration, so that tags added
+// outside of Terraform and the provider's default_tags don't show up as a
+// change.
+func networkingV2ReadAttributesTags(d *schema.ResourceData, config *Config, tags []string) {
+	d.Set("all_tags", tags)
+
+	desired := d.Get("tags").(*schema.Set)
+	actual := schema.NewSet(schema.HashString, nil)
+	for _, tag := range StripDefaultTags(config, d.Get("ignore_default_tags").(bool), tags, networkingV2AttributesTags(d)) {
+		if desired.Contains(tag) {
+			actual.Add(tag)
+		}
+	}
+	if !actual.Equal(desired) {
+		d.Set("tags", actual.List())
+	}
+}
+
+// networkingV2SetTags sets the tags of a Neutron resource to its configured
+// tags and the provider's default_tags when it is created or they changed,
+// replacing any tag added outside of Terraform.
+func networkingV2SetTags(client *gophercloud.ServiceClient, config *Config, d *schema.ResourceData, resourceType string) error {
+	tags := MergeDefaultTags(config, d.Get("ignore_default_tags").(bool), networkingV2AttributesTags(d))
+	if d.IsNewResource() {
+		if len(tags) == 0 {
+			return nil
+		}
+	} else if !d.HasChange("tags") && !d.HasChange("ignore_default_tags") {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Setting tags of %s %s: %v", resourceType, d.Id(), tags)
+	if err := networkingV2ReplaceTags(client, resourceType, d.Id(), tags); err != nil {
+		return fmt.Errorf("Error setting tags of %s %s: %s", resourceType, d.Id(), err)
+	}
+
+	return nil
+}
+
+// networkingV2ReplaceTags replaces all the tags of a Neutron resource, such as
+// a floating IP with the "floatingips" resource type.
+func networkingV2ReplaceTags(client *gophercloud.ServiceClient, resourceType, id string, tags []string) error {
+	b := map[string]interface{}{
+		"tags": tags,
+	}
+
+	var res map[string]interface{}
+	_, err := client.Put(client.ServiceURL(resourceType, id, "tags"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
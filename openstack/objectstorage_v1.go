@@ -0,0 +1,145 @@
+package openstack
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// objectStorageObjectV1MaxSize is the largest object whose content is read
+// into an attribute.
+const objectStorageObjectV1MaxSize = 1024 * 1024
+
+// ObjectStorageObject is an entry of a Swift container listing. Pseudo
+// directories returned when listing with a delimiter only have a Subdir.
+type ObjectStorageObject struct {
+	Name         string `json:"name"`
+	Subdir       string `json:"subdir"`
+	Hash         string `json:"hash"`
+	Bytes        int64  `json:"bytes"`
+	ContentType  string `json:"content_type"`
+	LastModified string `json:"last_modified"`
+}
+
+type ObjectStorageObjectListOpts struct {
+	Format    string `q:"format"`
+	Prefix    string `q:"prefix"`
+	Delimiter string `q:"delimiter"`
+	Marker    string `q:"marker"`
+}
+
+func objectStorageObjectV1List(client *gophercloud.ServiceClient, container string, opts ObjectStorageObjectListOpts) ([]ObjectStorageObject, error) {
+	var allObjects []ObjectStorageObject
+
+	opts.Format = "json"
+	for {
+		q, err := gophercloud.BuildQueryString(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// Swift returns 204 without a body for an empty container.
+		resp, err := client.Get(client.ServiceURL(container)+q.String(), nil, &gophercloud.RequestOpts{
+			OkCodes: []int{200, 204},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var objects []ObjectStorageObject
+		if resp.StatusCode == 200 {
+			err = json.NewDecoder(resp.Body).Decode(&objects)
+		}
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(objects) == 0 {
+			return allObjects, nil
+		}
+		allObjects = append(allObjects, objects...)
+
+		last := objects[len(objects)-1]
+		opts.Marker = last.Name
+		if last.Subdir != "" {
+			opts.Marker = last.Subdir
+		}
+	}
+}
+
+// objectStorageObjectV1Get downloads an object and returns its content and
+// headers. It fails if the object is larger than objectStorageObjectV1MaxSize.
+func objectStorageObjectV1Get(client *gophercloud.ServiceClient, container, name string) ([]byte, http.Header, error) {
+	resp, err := client.Get(client.ServiceURL(container, name), nil, &gophercloud.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: map[string]string{"Accept": ""},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.ContentLength > objectStorageObjectV1MaxSize {
+		return nil, nil, fmt.Errorf("Object is %d bytes, larger than the maximum of %d bytes",
+			resp.ContentLength, objectStorageObjectV1MaxSize)
+	}
+
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, objectStorageObjectV1MaxSize+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(content) > objectStorageObjectV1MaxSize {
+		return nil, nil, fmt.Errorf("Object is larger than the maximum of %d bytes",
+			objectStorageObjectV1MaxSize)
+	}
+
+	return content, resp.Header, nil
+}
+
+// objectStorageV1Metadata returns the custom metadata from the headers of a
+// container or an object, using the given prefix such as
+// "X-Object-Meta-".
+func objectStorageV1Metadata(header http.Header, prefix string) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range header {
+		if strings.HasPrefix(k, prefix) && len(v) > 0 {
+			metadata[strings.TrimPrefix(k, prefix)] = v[0]
+		}
+	}
+
+	return metadata
+}
+
+// objectStorageV1AccountMetadata returns the custom metadata of the account
+// of the client.
+func objectStorageV1AccountMetadata(client *gophercloud.ServiceClient) (map[string]string, error) {
+	resp, err := client.Request("HEAD", client.ServiceURL(), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return objectStorageV1Metadata(resp.Header, "X-Account-Meta-"), nil
+}
+
+// objectStorageV1FormPostSignature computes the signature of a form POST to
+// the given path, as expected by the Swift formpost middleware.
+func objectStorageV1FormPostSignature(key, path, redirect string, maxFileSize, maxFileCount int, expires int64) string {
+	body := fmt.Sprintf("%s\n%s\n%d\n%d\n%d", path, redirect, maxFileSize, maxFileCount, expires)
+
+	mac := hmac.New(sha1.New, []byte(key))
+	mac.Write([]byte(body))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
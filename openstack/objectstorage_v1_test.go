@@ -0,0 +1,15 @@
+package openstack
+
+import (
+	"testing"
+)
+
+func TestObjectStorageV1FormPostSignature(t *testing.T) {
+	signature := objectStorageV1FormPostSignature("secret", "/v1/AUTH_test/container/uploads/",
+		"https://example.com/done", 104857600, 10, 1500000000)
+
+	expected := "d1d851e5c5d9770d88ea09088be8cb81179fb372"
+	if signature != expected {
+		t.Fatalf("expected signature %s, got %s", expected, signature)
+	}
+}
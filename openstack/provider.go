@@ -11,7 +11,7 @@ var osMutexKV = mutexkv.NewMutexKV()
 
 // Provider returns a schema.Provider for OpenStack.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"auth_url": &schema.Schema{
 				Type:        schema.TypeString,
@@ -132,53 +132,144 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OS_SWAUTH", ""),
 				Description: descriptions["swauth"],
 			},
+
+			"default_metadata": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: descriptions["default_metadata"],
+			},
+
+			"default_tags": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: descriptions["default_tags"],
+			},
+
+			"neutron_revision_check": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["neutron_revision_check"],
+			},
+
+			"external_network_tag_priority": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["external_network_tag_priority"],
+			},
+
+			"external_network_prefer_default": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["external_network_prefer_default"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"openstack_images_image_v2":       dataSourceImagesImageV2(),
-			"openstack_networking_network_v2": dataSourceNetworkingNetworkV2(),
+			"openstack_blockstorage_volumes_v3":            dataSourceBlockStorageVolumesV3(),
+			"openstack_compute_flavor_v2":                  dataSourceComputeFlavorV2(),
+			"openstack_compute_hypervisor_capacity_v2":     dataSourceComputeHypervisorCapacityV2(),
+			"openstack_compute_instance_console_output_v2": dataSourceComputeInstanceConsoleOutputV2(),
+			"openstack_compute_instance_console_v2":        dataSourceComputeInstanceConsoleV2(),
+			"openstack_compute_keypair_v2":                 dataSourceComputeKeypairV2(),
+			"openstack_compute_limits_v2":                  dataSourceComputeLimitsV2(),
+			"openstack_compute_servergroup_v2":             dataSourceComputeServerGroupV2(),
+			"openstack_identity_role_assignments_v3":       dataSourceIdentityRoleAssignmentsV3(),
+			"openstack_images_image_v2":                    dataSourceImagesImageV2(),
+			"openstack_lb_amphorae_v2":                     dataSourceLBAmphoraeV2(),
+			"openstack_lb_flavor_v2":                       dataSourceLBFlavorV2(),
+			"openstack_lb_listener_v2":                     dataSourceLBListenerV2(),
+			"openstack_lb_loadbalancer_v2":                 dataSourceLBLoadBalancerV2(),
+			"openstack_lb_pool_v2":                         dataSourceLBPoolV2(),
+			"openstack_networking_availability_zones_v2":   dataSourceNetworkingAvailabilityZonesV2(),
+			"openstack_networking_network_v2":              dataSourceNetworkingNetworkV2(),
+			"openstack_networking_secgroups_v2":            dataSourceNetworkingSecGroupsV2(),
+			"openstack_objectstorage_container_v1":         dataSourceObjectStorageContainerV1(),
+			"openstack_objectstorage_formpost_v1":          dataSourceObjectStorageFormPostV1(),
+			"openstack_objectstorage_object_v1":            dataSourceObjectStorageObjectV1(),
+			"openstack_vpnaas_site_connection_v2":          dataSourceVPNaaSSiteConnectionV2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"openstack_blockstorage_volume_v1":          resourceBlockStorageVolumeV1(),
-			"openstack_blockstorage_volume_v2":          resourceBlockStorageVolumeV2(),
-			"openstack_blockstorage_volume_attach_v2":   resourceBlockStorageVolumeAttachV2(),
-			"openstack_compute_instance_v2":             resourceComputeInstanceV2(),
-			"openstack_compute_keypair_v2":              resourceComputeKeypairV2(),
-			"openstack_compute_secgroup_v2":             resourceComputeSecGroupV2(),
-			"openstack_compute_servergroup_v2":          resourceComputeServerGroupV2(),
-			"openstack_compute_floatingip_v2":           resourceComputeFloatingIPV2(),
-			"openstack_compute_floatingip_associate_v2": resourceComputeFloatingIPAssociateV2(),
-			"openstack_compute_volume_attach_v2":        resourceComputeVolumeAttachV2(),
-			"openstack_dns_recordset_v2":                resourceDNSRecordSetV2(),
-			"openstack_dns_zone_v2":                     resourceDNSZoneV2(),
-			"openstack_fw_firewall_v1":                  resourceFWFirewallV1(),
-			"openstack_fw_policy_v1":                    resourceFWPolicyV1(),
-			"openstack_fw_rule_v1":                      resourceFWRuleV1(),
-			"openstack_images_image_v2":                 resourceImagesImageV2(),
-			"openstack_lb_member_v1":                    resourceLBMemberV1(),
-			"openstack_lb_monitor_v1":                   resourceLBMonitorV1(),
-			"openstack_lb_pool_v1":                      resourceLBPoolV1(),
-			"openstack_lb_vip_v1":                       resourceLBVipV1(),
-			"openstack_lb_loadbalancer_v2":              resourceLoadBalancerV2(),
-			"openstack_lb_listener_v2":                  resourceListenerV2(),
-			"openstack_lb_pool_v2":                      resourcePoolV2(),
-			"openstack_lb_member_v2":                    resourceMemberV2(),
-			"openstack_lb_monitor_v2":                   resourceMonitorV2(),
-			"openstack_networking_network_v2":           resourceNetworkingNetworkV2(),
-			"openstack_networking_subnet_v2":            resourceNetworkingSubnetV2(),
-			"openstack_networking_floatingip_v2":        resourceNetworkingFloatingIPV2(),
-			"openstack_networking_port_v2":              resourceNetworkingPortV2(),
-			"openstack_networking_router_v2":            resourceNetworkingRouterV2(),
-			"openstack_networking_router_interface_v2":  resourceNetworkingRouterInterfaceV2(),
-			"openstack_networking_router_route_v2":      resourceNetworkingRouterRouteV2(),
-			"openstack_networking_secgroup_v2":          resourceNetworkingSecGroupV2(),
-			"openstack_networking_secgroup_rule_v2":     resourceNetworkingSecGroupRuleV2(),
-			"openstack_objectstorage_container_v1":      resourceObjectStorageContainerV1(),
+			"openstack_baremetal_deploy_template_v1":             resourceBaremetalDeployTemplateV1(),
+			"openstack_baremetal_node_traits_v1":                 resourceBaremetalNodeTraitsV1(),
+			"openstack_blockstorage_volume_v1":                   resourceBlockStorageVolumeV1(),
+			"openstack_blockstorage_volume_v2":                   resourceBlockStorageVolumeV2(),
+			"openstack_blockstorage_volume_attach_v2":            resourceBlockStorageVolumeAttachV2(),
+			"openstack_blockstorage_volume_metadata_v3":          resourceBlockStorageVolumeMetadataV3(),
+			"openstack_compute_flavor_access_v2":                 resourceComputeFlavorAccessV2(),
+			"openstack_compute_instance_migrate_v2":              resourceComputeInstanceMigrateV2(),
+			"openstack_compute_instance_v2":                      resourceComputeInstanceV2(),
+			"openstack_compute_instance_snapshot_v2":             resourceComputeInstanceSnapshotV2(),
+			"openstack_compute_interface_attach_v2":              resourceComputeInterfaceAttachV2(),
+			"openstack_compute_keypair_v2":                       resourceComputeKeypairV2(),
+			"openstack_compute_secgroup_v2":                      resourceComputeSecGroupV2(),
+			"openstack_compute_servergroup_v2":                   resourceComputeServerGroupV2(),
+			"openstack_containerinfra_quota_v1":                  resourceContainerInfraQuotaV1(),
+			"openstack_compute_floatingip_v2":                    resourceComputeFloatingIPV2(),
+			"openstack_compute_floatingip_associate_v2":          resourceComputeFloatingIPAssociateV2(),
+			"openstack_compute_volume_attach_v2":                 resourceComputeVolumeAttachV2(),
+			"openstack_dns_recordset_v2":                         resourceDNSRecordSetV2(),
+			"openstack_dns_zone_v2":                              resourceDNSZoneV2(),
+			"openstack_fw_firewall_v1":                           resourceFWFirewallV1(),
+			"openstack_fw_policy_v1":                             resourceFWPolicyV1(),
+			"openstack_fw_rule_v1":                               resourceFWRuleV1(),
+			"openstack_identity_region_v3":                       resourceIdentityRegionV3(),
+			"openstack_identity_trust_v3":                        resourceIdentityTrustV3(),
+			"openstack_images_image_v2":                          resourceImagesImageV2(),
+			"openstack_images_metadef_namespace_v2":              resourceImagesMetadefNamespaceV2(),
+			"openstack_images_metadef_object_v2":                 resourceImagesMetadefObjectV2(),
+			"openstack_images_metadef_property_v2":               resourceImagesMetadefPropertyV2(),
+			"openstack_infraoptim_audit_template_v1":             resourceInfraOptimAuditTemplateV1(),
+			"openstack_infraoptim_audit_v1":                      resourceInfraOptimAuditV1(),
+			"openstack_lb_member_v1":                             resourceLBMemberV1(),
+			"openstack_lb_monitor_v1":                            resourceLBMonitorV1(),
+			"openstack_lb_pool_v1":                               resourceLBPoolV1(),
+			"openstack_lb_vip_v1":                                resourceLBVipV1(),
+			"openstack_lb_loadbalancer_v2":                       resourceLoadBalancerV2(),
+			"openstack_lb_listener_v2":                           resourceListenerV2(),
+			"openstack_lb_pool_v2":                               resourcePoolV2(),
+			"openstack_lb_member_v2":                             resourceMemberV2(),
+			"openstack_lb_members_v2":                            resourceMembersV2(),
+			"openstack_lb_monitor_v2":                            resourceMonitorV2(),
+			"openstack_lb_flavor_v2":                             resourceLBFlavorV2(),
+			"openstack_lb_flavorprofile_v2":                      resourceLBFlavorProfileV2(),
+			"openstack_lb_failover_v2":                           resourceLBFailoverV2(),
+			"openstack_lb_quota_v2":                              resourceLBQuotaV2(),
+			"openstack_networking_auto_allocated_topology_v2":    resourceNetworkingAutoAllocatedTopologyV2(),
+			"openstack_networking_network_v2":                    resourceNetworkingNetworkV2(),
+			"openstack_networking_subnet_v2":                     resourceNetworkingSubnetV2(),
+			"openstack_networking_floatingip_v2":                 resourceNetworkingFloatingIPV2(),
+			"openstack_networking_port_v2":                       resourceNetworkingPortV2(),
+			"openstack_networking_qos_policy_v2":                 resourceNetworkingQoSPolicyV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":   resourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":      resourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2": resourceNetworkingQoSMinimumBandwidthRuleV2(),
+			"openstack_networking_router_v2":                     resourceNetworkingRouterV2(),
+			"openstack_networking_router_interface_v2":           resourceNetworkingRouterInterfaceV2(),
+			"openstack_networking_router_route_v2":               resourceNetworkingRouterRouteV2(),
+			"openstack_networking_router_routes_v2":              resourceNetworkingRouterRoutesV2(),
+			"openstack_networking_router_conntrack_helper_v2":    resourceNetworkingRouterConntrackHelperV2(),
+			"openstack_networking_secgroup_v2":                   resourceNetworkingSecGroupV2(),
+			"openstack_networking_secgroup_rule_v2":              resourceNetworkingSecGroupRuleV2(),
+			"openstack_objectstorage_container_v1":               resourceObjectStorageContainerV1(),
 		},
 
 		ConfigureFunc: configureProvider,
 	}
+
+	for _, r := range provider.DataSourcesMap {
+		withAPIErrorDetails(r)
+	}
+	for _, r := range provider.ResourcesMap {
+		withAPIErrorDetails(r)
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -217,26 +308,55 @@ func init() {
 
 		"swauth": "Use Swift's authentication system instead of Keystone. Only used for\n" +
 			"interaction with Swift.",
+
+		"default_metadata": "Metadata to add to every resource which supports metadata.",
+
+		"default_tags": "Tags to add to every resource which supports tags.",
+
+		"neutron_revision_check": "Only update networks, subnets and ports which have\n" +
+			"not been modified since they were last read.",
+
+		"external_network_tag_priority": "Tags used to choose between several external\n" +
+			"networks, from the most to the least preferred.",
+
+		"external_network_prefer_default": "Prefer the external network marked as the\n" +
+			"default one when choosing between several external networks.",
 	}
 }
 
 func configureProvider(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		CACertFile:       d.Get("cacert_file").(string),
-		ClientCertFile:   d.Get("cert").(string),
-		ClientKeyFile:    d.Get("key").(string),
-		DomainID:         d.Get("domain_id").(string),
-		DomainName:       d.Get("domain_name").(string),
-		EndpointType:     d.Get("endpoint_type").(string),
-		IdentityEndpoint: d.Get("auth_url").(string),
-		Insecure:         d.Get("insecure").(bool),
-		Password:         d.Get("password").(string),
-		Swauth:           d.Get("swauth").(bool),
-		Token:            d.Get("token").(string),
-		TenantID:         d.Get("tenant_id").(string),
-		TenantName:       d.Get("tenant_name").(string),
-		Username:         d.Get("user_name").(string),
-		UserID:           d.Get("user_id").(string),
+		CACertFile:           d.Get("cacert_file").(string),
+		ClientCertFile:       d.Get("cert").(string),
+		ClientKeyFile:        d.Get("key").(string),
+		DomainID:             d.Get("domain_id").(string),
+		DomainName:           d.Get("domain_name").(string),
+		EndpointType:         d.Get("endpoint_type").(string),
+		IdentityEndpoint:     d.Get("auth_url").(string),
+		Insecure:             d.Get("insecure").(bool),
+		NeutronRevisionCheck: d.Get("neutron_revision_check").(bool),
+		Password:             d.Get("password").(string),
+		Swauth:               d.Get("swauth").(bool),
+		Token:                d.Get("token").(string),
+		TenantID:             d.Get("tenant_id").(string),
+		TenantName:           d.Get("tenant_name").(string),
+		Username:             d.Get("user_name").(string),
+		UserID:               d.Get("user_id").(string),
+
+		ExternalNetworkPreferDefault: d.Get("external_network_prefer_default").(bool),
+	}
+
+	config.DefaultMetadata = make(map[string]string)
+	for k, v := range d.Get("default_metadata").(map[string]interface{}) {
+		config.DefaultMetadata[k] = v.(string)
+	}
+
+	for _, v := range d.Get("default_tags").(*schema.Set).List() {
+		config.DefaultTags = append(config.DefaultTags, v.(string))
+	}
+
+	for _, v := range d.Get("external_network_tag_priority").([]interface{}) {
+		config.ExternalNetworkTagPriority = append(config.ExternalNetworkTagPriority, v.(string))
 	}
 
 	if err := config.loadAndValidate(); err != nil {
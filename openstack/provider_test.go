@@ -13,14 +13,22 @@ import (
 )
 
 var (
-	OS_EXTGW_ID    = os.Getenv("OS_EXTGW_ID")
-	OS_FLAVOR_ID   = os.Getenv("OS_FLAVOR_ID")
-	OS_FLAVOR_NAME = os.Getenv("OS_FLAVOR_NAME")
-	OS_IMAGE_ID    = os.Getenv("OS_IMAGE_ID")
-	OS_IMAGE_NAME  = os.Getenv("OS_IMAGE_NAME")
-	OS_NETWORK_ID  = os.Getenv("OS_NETWORK_ID")
-	OS_POOL_NAME   = os.Getenv("OS_POOL_NAME")
-	OS_REGION_NAME = os.Getenv("OS_REGION_NAME")
+	OS_EXTGW_ID          = os.Getenv("OS_EXTGW_ID")
+	OS_FLAVOR_ID         = os.Getenv("OS_FLAVOR_ID")
+	OS_FLAVOR_NAME       = os.Getenv("OS_FLAVOR_NAME")
+	OS_IMAGE_ID          = os.Getenv("OS_IMAGE_ID")
+	OS_IMAGE_NAME        = os.Getenv("OS_IMAGE_NAME")
+	OS_NETWORK_ID        = os.Getenv("OS_NETWORK_ID")
+	OS_POOL_NAME         = os.Getenv("OS_POOL_NAME")
+	OS_PRIVATE_FLAVOR_ID = os.Getenv("OS_PRIVATE_FLAVOR_ID")
+	OS_REGION_NAME       = os.Getenv("OS_REGION_NAME")
+	OS_RESIZE_FLAVOR_ID  = os.Getenv("OS_RESIZE_FLAVOR_ID")
+	OS_TENANT_ID         = os.Getenv("OS_TENANT_ID")
+	OS_TLS_CONTAINER_REF = os.Getenv("OS_TLS_CONTAINER_REF")
+	OS_TRUSTEE_USER_ID   = os.Getenv("OS_TRUSTEE_USER_ID")
+	OS_VOLUME_TYPE       = os.Getenv("OS_VOLUME_TYPE")
+
+	OS_VPN_SITE_CONNECTION_ID = os.Getenv("OS_VPN_SITE_CONNECTION_ID")
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -67,6 +75,48 @@ func testAccPreCheckAdminOnly(t *testing.T) {
 	}
 }
 
+func testAccPreCheckTLSContainer(t *testing.T) {
+	if OS_TLS_CONTAINER_REF == "" {
+		t.Skip("OS_TLS_CONTAINER_REF is not set; skipping OpenStack TLS listener test.")
+	}
+}
+
+func testAccPreCheckVolumeType(t *testing.T) {
+	if OS_VOLUME_TYPE == "" {
+		t.Skip("OS_VOLUME_TYPE is not set; skipping OpenStack block device volume type test.")
+	}
+}
+
+func testAccPreCheckTenant(t *testing.T) {
+	if OS_TENANT_ID == "" {
+		t.Skip("OS_TENANT_ID is not set; skipping OpenStack project test.")
+	}
+}
+
+func testAccPreCheckVPNSiteConnection(t *testing.T) {
+	if OS_VPN_SITE_CONNECTION_ID == "" {
+		t.Skip("OS_VPN_SITE_CONNECTION_ID is not set; skipping OpenStack VPNaaS test.")
+	}
+}
+
+func testAccPreCheckPrivateFlavor(t *testing.T) {
+	if OS_PRIVATE_FLAVOR_ID == "" || OS_TENANT_ID == "" {
+		t.Skip("OS_PRIVATE_FLAVOR_ID or OS_TENANT_ID is not set; skipping OpenStack flavor access test.")
+	}
+}
+
+func testAccPreCheckResizeFlavor(t *testing.T) {
+	if OS_RESIZE_FLAVOR_ID == "" {
+		t.Skip("OS_RESIZE_FLAVOR_ID is not set; skipping OpenStack instance resize test.")
+	}
+}
+
+func testAccPreCheckTrustee(t *testing.T) {
+	if OS_TRUSTEE_USER_ID == "" {
+		t.Skip("OS_TRUSTEE_USER_ID is not set; skipping OpenStack identity trust test.")
+	}
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
@@ -0,0 +1,242 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBaremetalDeployTemplateV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaremetalDeployTemplateV1Create,
+		Read:   resourceBaremetalDeployTemplateV1Read,
+		Update: resourceBaremetalDeployTemplateV1Update,
+		Delete: resourceBaremetalDeployTemplateV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceBaremetalDeployTemplateV1ValidateName,
+			},
+
+			"steps": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interface": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: resourceBaremetalDeployTemplateV1ValidateInterface,
+						},
+						"step": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"args": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "{}",
+							ValidateFunc: resourceBaremetalDeployTemplateV1ValidateArgs,
+							StateFunc:    resourceBaremetalDeployTemplateV1NormalizeArgs,
+						},
+						"priority": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"extra": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceBaremetalDeployTemplateV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	steps, err := resourceBaremetalDeployTemplateV1Steps(d)
+	if err != nil {
+		return err
+	}
+
+	createOpts := BaremetalDeployTemplate{
+		Name:  d.Get("name").(string),
+		Steps: steps,
+		Extra: d.Get("extra").(map[string]interface{}),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	template, err := baremetalDeployTemplateV1Create(baremetalClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal deploy template: %s", err)
+	}
+	log.Printf("[INFO] Deploy template ID: %s", template.UUID)
+
+	d.SetId(template.UUID)
+
+	return resourceBaremetalDeployTemplateV1Read(d, meta)
+}
+
+func resourceBaremetalDeployTemplateV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	template, err := baremetalDeployTemplateV1Get(baremetalClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "baremetal deploy template")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack baremetal deploy template %s: %+v", d.Id(), template)
+
+	steps := make([]map[string]interface{}, len(template.Steps))
+	for i, step := range template.Steps {
+		args, err := json.Marshal(step.Args)
+		if err != nil {
+			return fmt.Errorf("Error encoding args of step %s: %s", step.Step, err)
+		}
+
+		steps[i] = map[string]interface{}{
+			"interface": step.Interface,
+			"step":      step.Step,
+			"args":      string(args),
+			"priority":  step.Priority,
+		}
+	}
+
+	d.Set("name", template.Name)
+	d.Set("steps", steps)
+	d.Set("extra", template.Extra)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceBaremetalDeployTemplateV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	var patches []JSONPatch
+	if d.HasChange("name") {
+		patches = append(patches, JSONPatch{Op: "replace", Path: "/name", Value: d.Get("name").(string)})
+	}
+	if d.HasChange("steps") {
+		steps, err := resourceBaremetalDeployTemplateV1Steps(d)
+		if err != nil {
+			return err
+		}
+		patches = append(patches, JSONPatch{Op: "replace", Path: "/steps", Value: steps})
+	}
+	if d.HasChange("extra") {
+		patches = append(patches, JSONPatch{Op: "replace", Path: "/extra", Value: d.Get("extra").(map[string]interface{})})
+	}
+
+	log.Printf("[DEBUG] Updating OpenStack baremetal deploy template %s with patches: %+v", d.Id(), patches)
+
+	if err := baremetalDeployTemplateV1Update(baremetalClient, d.Id(), patches); err != nil {
+		return fmt.Errorf("Error updating OpenStack baremetal deploy template: %s", err)
+	}
+
+	return resourceBaremetalDeployTemplateV1Read(d, meta)
+}
+
+func resourceBaremetalDeployTemplateV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	if err := baremetalDeployTemplateV1Delete(baremetalClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "baremetal deploy template")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceBaremetalDeployTemplateV1Steps(d *schema.ResourceData) ([]BaremetalDeployStep, error) {
+	rawSteps := d.Get("steps").([]interface{})
+	steps := make([]BaremetalDeployStep, len(rawSteps))
+	for i, raw := range rawSteps {
+		rawStep := raw.(map[string]interface{})
+
+		var args map[string]interface{}
+		if err := json.Unmarshal([]byte(rawStep["args"].(string)), &args); err != nil {
+			return nil, fmt.Errorf("Error decoding args of step %s: %s", rawStep["step"], err)
+		}
+
+		steps[i] = BaremetalDeployStep{
+			Interface: rawStep["interface"].(string),
+			Step:      rawStep["step"].(string),
+			Args:      args,
+			Priority:  rawStep["priority"].(int),
+		}
+	}
+
+	return steps, nil
+}
+
+func resourceBaremetalDeployTemplateV1ValidateName(v interface{}, k string) (ws []string, errors []error) {
+	if !strings.HasPrefix(v.(string), "CUSTOM_") {
+		errors = append(errors, fmt.Errorf("%q must be a custom trait starting with CUSTOM_", k))
+	}
+	return
+}
+
+func resourceBaremetalDeployTemplateV1ValidateInterface(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "bios", "deploy", "management", "power", "raid":
+		return
+	}
+	errors = append(errors, fmt.Errorf("Only 'bios', 'deploy', 'management', 'power' and 'raid' are supported values for %q", k))
+	return
+}
+
+func resourceBaremetalDeployTemplateV1ValidateArgs(v interface{}, k string) (ws []string, errors []error) {
+	var args map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &args); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
+
+// resourceBaremetalDeployTemplateV1NormalizeArgs re-encodes the step
+// arguments so that whitespace and key order don't cause a diff.
+func resourceBaremetalDeployTemplateV1NormalizeArgs(v interface{}) string {
+	var args map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &args); err != nil {
+		return v.(string)
+	}
+
+	b, _ := json.Marshal(args)
+	return string(b)
+}
@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBaremetalV1DeployTemplate_basic(t *testing.T) {
+	var template BaremetalDeployTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBaremetalV1DeployTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBaremetalV1DeployTemplate_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaremetalV1DeployTemplateExists(
+						"openstack_baremetal_deploy_template_v1.template_1", &template),
+					resource.TestCheckResourceAttr(
+						"openstack_baremetal_deploy_template_v1.template_1", "name", "CUSTOM_HYPERTHREADING_ON"),
+					resource.TestCheckResourceAttr(
+						"openstack_baremetal_deploy_template_v1.template_1", "steps.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_baremetal_deploy_template_v1.template_1", "steps.0.interface", "bios"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBaremetalV1DeployTemplate_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_baremetal_deploy_template_v1.template_1", "name", "CUSTOM_RAID1"),
+					resource.TestCheckResourceAttr(
+						"openstack_baremetal_deploy_template_v1.template_1", "steps.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_baremetal_deploy_template_v1.template_1", "extra.owner", "terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBaremetalV1DeployTemplateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	baremetalClient, err := config.baremetalV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_baremetal_deploy_template_v1" {
+			continue
+		}
+
+		_, err := baremetalDeployTemplateV1Get(baremetalClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Deploy template still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBaremetalV1DeployTemplateExists(n string, template *BaremetalDeployTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		baremetalClient, err := config.baremetalV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+		}
+
+		found, err := baremetalDeployTemplateV1Get(baremetalClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.UUID != rs.Primary.ID {
+			return fmt.Errorf("Deploy template not found")
+		}
+
+		*template = *found
+
+		return nil
+	}
+}
+
+const testAccBaremetalV1DeployTemplate_basic = `
+resource "openstack_baremetal_deploy_template_v1" "template_1" {
+  name = "CUSTOM_HYPERTHREADING_ON"
+
+  steps {
+    interface = "bios"
+    step      = "apply_configuration"
+    priority  = 150
+    args      = <<EOF
+{
+  "settings": [
+    {"name": "LogicalProc", "value": "Enabled"}
+  ]
+}
+EOF
+  }
+}
+`
+
+const testAccBaremetalV1DeployTemplate_update = `
+resource "openstack_baremetal_deploy_template_v1" "template_1" {
+  name = "CUSTOM_RAID1"
+
+  steps {
+    interface = "raid"
+    step      = "delete_configuration"
+    priority  = 110
+  }
+
+  steps {
+    interface = "raid"
+    step      = "apply_configuration"
+    priority  = 100
+    args      = <<EOF
+{
+  "raid_config": {
+    "logical_disks": [
+      {"size_gb": "MAX", "raid_level": "1", "is_root_volume": true}
+    ]
+  }
+}
+EOF
+  }
+
+  extra {
+    owner = "terraform"
+  }
+}
+`
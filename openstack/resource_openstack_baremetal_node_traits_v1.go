@@ -0,0 +1,126 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBaremetalNodeTraitsV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaremetalNodeTraitsV1Create,
+		Read:   resourceBaremetalNodeTraitsV1Read,
+		Update: resourceBaremetalNodeTraitsV1Update,
+		Delete: resourceBaremetalNodeTraitsV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"node_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"traits": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceBaremetalNodeTraitsV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	nodeID := d.Get("node_id").(string)
+	traits := resourceBaremetalNodeTraitsV1Traits(d)
+
+	log.Printf("[DEBUG] Setting traits of OpenStack baremetal node %s: %v", nodeID, traits)
+	if err := baremetalNodeTraitsV1Set(baremetalClient, nodeID, traits); err != nil {
+		return fmt.Errorf("Error setting traits of OpenStack baremetal node %s: %s", nodeID, err)
+	}
+
+	d.SetId(nodeID)
+
+	return resourceBaremetalNodeTraitsV1Read(d, meta)
+}
+
+func resourceBaremetalNodeTraitsV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	traits, err := baremetalNodeTraitsV1Get(baremetalClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "baremetal node traits")
+	}
+
+	log.Printf("[DEBUG] Retrieved traits of OpenStack baremetal node %s: %v", d.Id(), traits)
+
+	d.Set("node_id", d.Id())
+	d.Set("traits", traits)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceBaremetalNodeTraitsV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	if d.HasChange("traits") {
+		traits := resourceBaremetalNodeTraitsV1Traits(d)
+
+		log.Printf("[DEBUG] Setting traits of OpenStack baremetal node %s: %v", d.Id(), traits)
+		if err := baremetalNodeTraitsV1Set(baremetalClient, d.Id(), traits); err != nil {
+			return fmt.Errorf("Error setting traits of OpenStack baremetal node %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBaremetalNodeTraitsV1Read(d, meta)
+}
+
+func resourceBaremetalNodeTraitsV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	baremetalClient, err := config.baremetalV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack baremetal client: %s", err)
+	}
+
+	if err := baremetalNodeTraitsV1Delete(baremetalClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "baremetal node traits")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceBaremetalNodeTraitsV1Traits(d *schema.ResourceData) []string {
+	rawTraits := d.Get("traits").(*schema.Set).List()
+	traits := make([]string, len(rawTraits))
+	for i, raw := range rawTraits {
+		traits[i] = raw.(string)
+	}
+	return traits
+}
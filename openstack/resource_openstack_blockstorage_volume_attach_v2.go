@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
 	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
 
@@ -229,13 +230,16 @@ func resourceBlockStorageVolumeAttachV2Create(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	// Wait for the volume to become available.
-	log.Printf("[DEBUG] Waiting for volume (%s) to become available", volumeId)
+	// Wait for the attachment of this host to show up. A multiattach volume
+	// may already be in use by other hosts, so the volume status alone
+	// is not enough.
+	hostName := d.Get("host_name").(string)
+	log.Printf("[DEBUG] Waiting for volume (%s) to be attached to %s", volumeId, hostName)
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"available", "attaching"},
-		Target:     []string{"in-use"},
-		Refresh:    VolumeV2StateRefreshFunc(client, volumeId),
+		Pending:    []string{"ATTACHING"},
+		Target:     []string{"ATTACHED"},
+		Refresh:    blockStorageVolumeAttachV2AttachFunc(client, volumeId, hostName),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -255,7 +259,6 @@ func resourceBlockStorageVolumeAttachV2Create(d *schema.ResourceData, meta inter
 
 	// Search for the attachmentId
 	var attachmentId string
-	hostName := d.Get("host_name").(string)
 	for _, attachment := range volume.Attachments {
 		if hostName != "" && hostName == attachment.HostName {
 			attachmentId = attachment.AttachmentID
@@ -370,10 +373,12 @@ func resourceBlockStorageVolumeAttachV2Delete(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	// Other attachments of a multiattach volume keep it in use, so only
+	// wait for this attachment to go away.
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"in-use", "attaching", "detaching"},
-		Target:     []string{"available"},
-		Refresh:    VolumeV2StateRefreshFunc(client, volumeId),
+		Pending:    []string{"DETACHING"},
+		Target:     []string{"DETACHED"},
+		Refresh:    blockStorageVolumeAttachV2DetachFunc(client, volumeId, attachmentId),
 		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -381,7 +386,7 @@ func resourceBlockStorageVolumeAttachV2Delete(d *schema.ResourceData, meta inter
 
 	_, err = stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf("Error waiting for volume (%s) to become available: %s", volumeId, err)
+		return fmt.Errorf("Error waiting for volume (%s) to be detached: %s", volumeId, err)
 	}
 
 	return nil
@@ -404,6 +409,60 @@ func blockStorageVolumeAttachV2AttachMode(v string) (volumeactions.AttachMode, e
 	return attachMode, attachError
 }
 
+func blockStorageVolumeAttachV2AttachFunc(
+	client *gophercloud.ServiceClient, volumeId, hostName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := volumes.Get(client, volumeId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v.Status == "error" {
+			return v, v.Status, fmt.Errorf("The volume is in error status. " +
+				"Please check with your cloud admin or check the Block Storage " +
+				"API logs to see why this error occurred.")
+		}
+
+		if v.Status == "in-use" {
+			for _, attachment := range v.Attachments {
+				if hostName != "" && hostName == attachment.HostName {
+					return v, "ATTACHED", nil
+				}
+			}
+		}
+
+		return v, "ATTACHING", nil
+	}
+}
+
+func blockStorageVolumeAttachV2DetachFunc(
+	client *gophercloud.ServiceClient, volumeId, attachmentId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := volumes.Get(client, volumeId).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v.Status == "error" {
+			return v, v.Status, fmt.Errorf("The volume is in error status. " +
+				"Please check with your cloud admin or check the Block Storage " +
+				"API logs to see why this error occurred.")
+		}
+
+		if v.Status == "detaching" {
+			return v, "DETACHING", nil
+		}
+
+		for _, attachment := range v.Attachments {
+			if attachment.AttachmentID == attachmentId {
+				return v, "DETACHING", nil
+			}
+		}
+
+		return v, "DETACHED", nil
+	}
+}
+
 func blockStorageVolumeAttachV2ParseId(id string) (string, string, error) {
 	parts := strings.Split(id, "/")
 	if len(parts) < 2 {
@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageVolumeMetadataV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeMetadataV3Create,
+		Read:   resourceBlockStorageVolumeMetadataV3Read,
+		Update: resourceBlockStorageVolumeMetadataV3Update,
+		Delete: resourceBlockStorageVolumeMetadataV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeMetadataV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeID := d.Get("volume_id").(string)
+	metadata := resourceBlockStorageVolumeMetadataV3Metadata(d.Get("metadata"))
+
+	log.Printf("[DEBUG] Adding metadata to OpenStack volume %s: %v", volumeID, metadata)
+	if err := blockStorageVolumeMetadataV3Merge(blockStorageClient, volumeID, metadata); err != nil {
+		return fmt.Errorf("Error adding metadata to OpenStack volume %s: %s", volumeID, err)
+	}
+
+	d.SetId(volumeID)
+
+	return resourceBlockStorageVolumeMetadataV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeMetadataV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	allMetadata, err := blockStorageVolumeMetadataV3Get(blockStorageClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "volume metadata")
+	}
+
+	log.Printf("[DEBUG] Retrieved metadata of OpenStack volume %s: %v", d.Id(), allMetadata)
+
+	// Only the keys managed by this resource are read, so the metadata added
+	// by others, such as a CSI driver, doesn't show up as a change. All the
+	// keys are adopted when the resource is imported.
+	managed := d.Get("metadata").(map[string]interface{})
+	metadata := make(map[string]string)
+	for k, v := range allMetadata {
+		if _, ok := managed[k]; ok || len(managed) == 0 {
+			metadata[k] = v
+		}
+	}
+
+	d.Set("volume_id", d.Id())
+	d.Set("metadata", metadata)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeMetadataV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if d.HasChange("metadata") {
+		o, n := d.GetChange("metadata")
+		oldMetadata := resourceBlockStorageVolumeMetadataV3Metadata(o)
+		newMetadata := resourceBlockStorageVolumeMetadataV3Metadata(n)
+
+		for key := range oldMetadata {
+			if _, ok := newMetadata[key]; ok {
+				continue
+			}
+
+			log.Printf("[DEBUG] Removing metadata key %s from OpenStack volume %s", key, d.Id())
+			if err := blockStorageVolumeMetadataV3DeleteKey(blockStorageClient, d.Id(), key); err != nil {
+				if _, ok := err.(gophercloud.ErrDefault404); !ok {
+					return fmt.Errorf("Error removing metadata key %s from OpenStack volume %s: %s", key, d.Id(), err)
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Adding metadata to OpenStack volume %s: %v", d.Id(), newMetadata)
+		if err := blockStorageVolumeMetadataV3Merge(blockStorageClient, d.Id(), newMetadata); err != nil {
+			return fmt.Errorf("Error adding metadata to OpenStack volume %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageVolumeMetadataV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeMetadataV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for key := range resourceBlockStorageVolumeMetadataV3Metadata(d.Get("metadata")) {
+		log.Printf("[DEBUG] Removing metadata key %s from OpenStack volume %s", key, d.Id())
+		if err := blockStorageVolumeMetadataV3DeleteKey(blockStorageClient, d.Id(), key); err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				return fmt.Errorf("Error removing metadata key %s from OpenStack volume %s: %s", key, d.Id(), err)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceBlockStorageVolumeMetadataV3Metadata(raw interface{}) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range raw.(map[string]interface{}) {
+		metadata[k] = v.(string)
+	}
+	return metadata
+}
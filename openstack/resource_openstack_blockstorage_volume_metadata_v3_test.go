@@ -0,0 +1,97 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageVolumeMetadataV3_basic(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV2VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageVolumeMetadataV3_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_1", &volume),
+					testAccCheckBlockStorageV2VolumeMetadata(&volume, "csi", "true"),
+					testAccCheckBlockStorageV2VolumeMetadata(&volume, "team", "db"),
+					testAccCheckBlockStorageV2VolumeMetadata(&volume, "env", "test"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_metadata_v3.metadata_1", "metadata.%", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageVolumeMetadataV3_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_1", &volume),
+					testAccCheckBlockStorageV2VolumeMetadata(&volume, "csi", "true"),
+					testAccCheckBlockStorageV2VolumeMetadata(&volume, "team", "web"),
+					testAccCheckBlockStorageVolumeMetadataV3NoKey(&volume, "env"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_metadata_v3.metadata_1", "metadata.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageVolumeMetadataV3NoKey(volume *volumes.Volume, k string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := volume.Metadata[k]; ok {
+			return fmt.Errorf("Metadata key %s still exists", k)
+		}
+
+		return nil
+	}
+}
+
+const testAccBlockStorageVolumeMetadataV3_basic = `
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 1
+  metadata {
+    csi = "true"
+  }
+
+  lifecycle {
+    ignore_changes = ["metadata"]
+  }
+}
+
+resource "openstack_blockstorage_volume_metadata_v3" "metadata_1" {
+  volume_id = "${openstack_blockstorage_volume_v2.volume_1.id}"
+  metadata {
+    team = "db"
+    env = "test"
+  }
+}
+`
+
+const testAccBlockStorageVolumeMetadataV3_update = `
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 1
+  metadata {
+    csi = "true"
+  }
+
+  lifecycle {
+    ignore_changes = ["metadata"]
+  }
+}
+
+resource "openstack_blockstorage_volume_metadata_v3" "metadata_1" {
+  volume_id = "${openstack_blockstorage_volume_v2.volume_1.id}"
+  metadata {
+    team = "web"
+  }
+}
+`
@@ -36,6 +36,11 @@ func resourceBlockStorageVolumeV1() *schema.Resource {
 				ForceNew:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
 			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"size": &schema.Schema{
 				Type:     schema.TypeInt,
 				Required: true,
@@ -63,6 +68,21 @@ func resourceBlockStorageVolumeV1() *schema.Resource {
 				ForceNew: false,
 				Computed: true,
 			},
+			"bootable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"read_only": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"ignore_default_metadata": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"snapshot_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -84,6 +104,7 @@ func resourceBlockStorageVolumeV1() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"scheduler_hints": resourceBlockStorageSchedulerHintsSchema(),
 			"attachment": &schema.Schema{
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -110,13 +131,18 @@ func resourceBlockStorageVolumeV1() *schema.Resource {
 }
 
 func resourceBlockStorageVolumeV1Create(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+	config, err := meta.(*Config).projectConfig(d.Get("project_id").(string), GetRegion(d))
+	if err != nil {
+		return err
+	}
 	blockStorageClient, err := config.blockStorageV1Client(GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
 	}
 
-	createOpts := &volumes.CreateOpts{
+	var createOpts volumes.CreateOptsBuilder
+
+	createOpts = &volumes.CreateOpts{
 		Description:      d.Get("description").(string),
 		AvailabilityZone: d.Get("availability_zone").(string),
 		Name:             d.Get("name").(string),
@@ -125,7 +151,18 @@ func resourceBlockStorageVolumeV1Create(d *schema.ResourceData, meta interface{}
 		SourceVolID:      d.Get("source_vol_id").(string),
 		ImageID:          d.Get("image_id").(string),
 		VolumeType:       d.Get("volume_type").(string),
-		Metadata:         resourceContainerMetadataV2(d),
+		Metadata:         MergeDefaultMetadata(config, d.Get("ignore_default_metadata").(bool), resourceContainerMetadataV2(d)),
+	}
+
+	if schedulerHints, ok := resourceBlockStorageSchedulerHints(d); ok {
+		if err := resourceBlockStorageSchedulerHintsCheckAZ(d, meta, schedulerHints); err != nil {
+			return err
+		}
+
+		createOpts = &VolumeSchedulerHintsCreateOptsExt{
+			VolumeCreateOptsBuilder: createOpts,
+			SchedulerHints:          schedulerHints,
+		}
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -159,11 +196,18 @@ func resourceBlockStorageVolumeV1Create(d *schema.ResourceData, meta interface{}
 	// Store the ID now
 	d.SetId(v.ID)
 
+	if err := resourceBlockStorageVolumeFlags(blockStorageClient, d); err != nil {
+		return err
+	}
+
 	return resourceBlockStorageVolumeV1Read(d, meta)
 }
 
 func resourceBlockStorageVolumeV1Read(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+	config, err := meta.(*Config).projectConfig(d.Get("project_id").(string), GetRegion(d))
+	if err != nil {
+		return err
+	}
 
 	blockStorageClient, err := config.blockStorageV1Client(GetRegion(d))
 	if err != nil {
@@ -184,7 +228,10 @@ func resourceBlockStorageVolumeV1Read(d *schema.ResourceData, meta interface{})
 	d.Set("snapshot_id", v.SnapshotID)
 	d.Set("source_vol_id", v.SourceVolID)
 	d.Set("volume_type", v.VolumeType)
-	d.Set("metadata", v.Metadata)
+	readOnly, metadata := blockStorageVolumeReadOnly(v.Metadata)
+	d.Set("bootable", v.Bootable == "true")
+	d.Set("read_only", readOnly)
+	d.Set("metadata", StripDefaultMetadata(config, d.Get("ignore_default_metadata").(bool), metadata, resourceVolumeMetadataV1(d)))
 	d.Set("region", GetRegion(d))
 
 	attachments := make([]map[string]interface{}, len(v.Attachments))
@@ -201,7 +248,10 @@ func resourceBlockStorageVolumeV1Read(d *schema.ResourceData, meta interface{})
 }
 
 func resourceBlockStorageVolumeV1Update(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+	config, err := meta.(*Config).projectConfig(d.Get("project_id").(string), GetRegion(d))
+	if err != nil {
+		return err
+	}
 	blockStorageClient, err := config.blockStorageV1Client(GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
@@ -212,8 +262,8 @@ func resourceBlockStorageVolumeV1Update(d *schema.ResourceData, meta interface{}
 		Description: d.Get("description").(string),
 	}
 
-	if d.HasChange("metadata") {
-		updateOpts.Metadata = resourceVolumeMetadataV1(d)
+	if d.HasChange("metadata") || d.HasChange("ignore_default_metadata") {
+		updateOpts.Metadata = MergeDefaultMetadata(config, d.Get("ignore_default_metadata").(bool), resourceVolumeMetadataV1(d))
 	}
 
 	_, err = volumes.Update(blockStorageClient, d.Id(), updateOpts).Extract()
@@ -221,11 +271,18 @@ func resourceBlockStorageVolumeV1Update(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error updating OpenStack volume: %s", err)
 	}
 
+	if err := resourceBlockStorageVolumeFlags(blockStorageClient, d); err != nil {
+		return err
+	}
+
 	return resourceBlockStorageVolumeV1Read(d, meta)
 }
 
 func resourceBlockStorageVolumeV1Delete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+	config, err := meta.(*Config).projectConfig(d.Get("project_id").(string), GetRegion(d))
+	if err != nil {
+		return err
+	}
 	blockStorageClient, err := config.blockStorageV1Client(GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
@@ -79,6 +79,30 @@ func TestAccBlockStorageV1Volume_timeout(t *testing.T) {
 	})
 }
 
+func TestAccBlockStorageV1Volume_project(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckTenant(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV1VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV1Volume_project,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV1VolumeExists("openstack_blockstorage_volume_v1.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v1.volume_1", "project_id", OS_TENANT_ID),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockStorageV1VolumeDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	blockStorageClient, err := config.blockStorageV1Client(OS_REGION_NAME)
@@ -207,6 +231,14 @@ resource "openstack_blockstorage_volume_v1" "volume_1" {
 }
 `, OS_IMAGE_ID)
 
+var testAccBlockStorageV1Volume_project = fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v1" "volume_1" {
+  name = "volume_1"
+  size = 1
+  project_id = "%s"
+}
+`, OS_TENANT_ID)
+
 const testAccBlockStorageV1Volume_timeout = `
 resource "openstack_blockstorage_volume_v1" "volume_1" {
   name = "volume_1"
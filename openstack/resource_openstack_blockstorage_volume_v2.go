@@ -36,6 +36,11 @@ func resourceBlockStorageVolumeV2() *schema.Resource {
 				ForceNew:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
 			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"size": &schema.Schema{
 				Type:     schema.TypeInt,
 				Required: true,
@@ -63,6 +68,21 @@ func resourceBlockStorageVolumeV2() *schema.Resource {
 				ForceNew: false,
 				Computed: true,
 			},
+			"bootable": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"read_only": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"ignore_default_metadata": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"snapshot_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -84,6 +104,7 @@ func resourceBlockStorageVolumeV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"scheduler_hints": resourceBlockStorageSchedulerHintsSchema(),
 			"consistency_group_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -94,6 +115,12 @@ func resourceBlockStorageVolumeV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"multiattach": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
 			"attachment": &schema.Schema{
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -115,29 +142,52 @@ func resourceBlockStorageVolumeV2() *schema.Resource {
 				},
 				Set: resourceVolumeV2AttachmentHash,
 			},
+			"volume_image_metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceBlockStorageVolumeV2Create(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+	config, err := meta.(*Config).projectConfig(d.Get("project_id").(string), GetRegion(d))
+	if err != nil {
+		return err
+	}
 	blockStorageClient, err := config.blockStorageV2Client(GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
 	}
 
-	createOpts := &volumes.CreateOpts{
-		AvailabilityZone:   d.Get("availability_zone").(string),
-		ConsistencyGroupID: d.Get("consistency_group_id").(string),
-		Description:        d.Get("description").(string),
-		ImageID:            d.Get("image_id").(string),
-		Metadata:           resourceContainerMetadataV2(d),
-		Name:               d.Get("name").(string),
-		Size:               d.Get("size").(int),
-		SnapshotID:         d.Get("snapshot_id").(string),
-		SourceReplica:      d.Get("source_replica").(string),
-		SourceVolID:        d.Get("source_vol_id").(string),
-		VolumeType:         d.Get("volume_type").(string),
+	var createOpts volumes.CreateOptsBuilder
+
+	createOpts = &VolumeCreateOpts{
+		volumes.CreateOpts{
+			AvailabilityZone:   d.Get("availability_zone").(string),
+			ConsistencyGroupID: d.Get("consistency_group_id").(string),
+			Description:        d.Get("description").(string),
+			ImageID:            d.Get("image_id").(string),
+			Metadata:           MergeDefaultMetadata(config, d.Get("ignore_default_metadata").(bool), resourceContainerMetadataV2(d)),
+			Name:               d.Get("name").(string),
+			Size:               d.Get("size").(int),
+			SnapshotID:         d.Get("snapshot_id").(string),
+			SourceReplica:      d.Get("source_replica").(string),
+			SourceVolID:        d.Get("source_vol_id").(string),
+			VolumeType:         d.Get("volume_type").(string),
+		},
+		d.Get("multiattach").(bool),
+	}
+
+	if schedulerHints, ok := resourceBlockStorageSchedulerHints(d); ok {
+		if err := resourceBlockStorageSchedulerHintsCheckAZ(d, meta, schedulerHints); err != nil {
+			return err
+		}
+
+		createOpts = &VolumeSchedulerHintsCreateOptsExt{
+			VolumeCreateOptsBuilder: createOpts,
+			SchedulerHints:          schedulerHints,
+		}
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -171,23 +221,41 @@ func resourceBlockStorageVolumeV2Create(d *schema.ResourceData, meta interface{}
 	// Store the ID now
 	d.SetId(v.ID)
 
+	if err := resourceBlockStorageVolumeFlags(blockStorageClient, d); err != nil {
+		return err
+	}
+
 	return resourceBlockStorageVolumeV2Read(d, meta)
 }
 
 func resourceBlockStorageVolumeV2Read(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+	config, err := meta.(*Config).projectConfig(d.Get("project_id").(string), GetRegion(d))
+	if err != nil {
+		return err
+	}
 	blockStorageClient, err := config.blockStorageV2Client(GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
 	}
 
-	v, err := volumes.Get(blockStorageClient, d.Id()).Extract()
+	r := volumes.Get(blockStorageClient, d.Id())
+	v, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "volume")
 	}
 
 	log.Printf("[DEBUG] Retrieved volume %s: %+v", d.Id(), v)
 
+	// volume_image_metadata is only returned for volumes created from an image.
+	// It is extracted separately because volumes.Volume has its own
+	// UnmarshalJSON which would hide any fields of an embedding struct.
+	var volumeExtensions struct {
+		VolumeImageMetadata map[string]string `json:"volume_image_metadata"`
+	}
+	if err := r.ExtractInto(&volumeExtensions); err != nil {
+		return fmt.Errorf("Error extracting extensions of volume %s: %s", d.Id(), err)
+	}
+
 	d.Set("size", v.Size)
 	d.Set("description", v.Description)
 	d.Set("availability_zone", v.AvailabilityZone)
@@ -195,7 +263,12 @@ func resourceBlockStorageVolumeV2Read(d *schema.ResourceData, meta interface{})
 	d.Set("snapshot_id", v.SnapshotID)
 	d.Set("source_vol_id", v.SourceVolID)
 	d.Set("volume_type", v.VolumeType)
-	d.Set("metadata", v.Metadata)
+	d.Set("multiattach", v.Multiattach)
+	readOnly, metadata := blockStorageVolumeReadOnly(v.Metadata)
+	d.Set("bootable", v.Bootable == "true")
+	d.Set("read_only", readOnly)
+	d.Set("metadata", StripDefaultMetadata(config, d.Get("ignore_default_metadata").(bool), metadata, resourceVolumeMetadataV2(d)))
+	d.Set("volume_image_metadata", volumeExtensions.VolumeImageMetadata)
 	d.Set("region", GetRegion(d))
 
 	attachments := make([]map[string]interface{}, len(v.Attachments))
@@ -212,7 +285,10 @@ func resourceBlockStorageVolumeV2Read(d *schema.ResourceData, meta interface{})
 }
 
 func resourceBlockStorageVolumeV2Update(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+	config, err := meta.(*Config).projectConfig(d.Get("project_id").(string), GetRegion(d))
+	if err != nil {
+		return err
+	}
 	blockStorageClient, err := config.blockStorageV2Client(GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
@@ -223,8 +299,8 @@ func resourceBlockStorageVolumeV2Update(d *schema.ResourceData, meta interface{}
 		Description: d.Get("description").(string),
 	}
 
-	if d.HasChange("metadata") {
-		updateOpts.Metadata = resourceVolumeMetadataV2(d)
+	if d.HasChange("metadata") || d.HasChange("ignore_default_metadata") {
+		updateOpts.Metadata = MergeDefaultMetadata(config, d.Get("ignore_default_metadata").(bool), resourceVolumeMetadataV2(d))
 	}
 
 	_, err = volumes.Update(blockStorageClient, d.Id(), updateOpts).Extract()
@@ -232,11 +308,18 @@ func resourceBlockStorageVolumeV2Update(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error updating OpenStack volume: %s", err)
 	}
 
+	if err := resourceBlockStorageVolumeFlags(blockStorageClient, d); err != nil {
+		return err
+	}
+
 	return resourceBlockStorageVolumeV2Read(d, meta)
 }
 
 func resourceBlockStorageVolumeV2Delete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
+	config, err := meta.(*Config).projectConfig(d.Get("project_id").(string), GetRegion(d))
+	if err != nil {
+		return err
+	}
 	blockStorageClient, err := config.blockStorageV2Client(GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
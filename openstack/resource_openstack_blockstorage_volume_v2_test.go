@@ -41,6 +41,41 @@ func TestAccBlockStorageV2Volume_basic(t *testing.T) {
 	})
 }
 
+func TestAccBlockStorageV2Volume_flags(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV2VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV2Volume_flags_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_1", &volume),
+					testAccCheckBlockStorageV2VolumeMetadata(&volume, "foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "bootable", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "read_only", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "metadata.%", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV2Volume_flags_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "bootable", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "read_only", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBlockStorageV2Volume_image(t *testing.T) {
 	var volume volumes.Volume
 
@@ -55,6 +90,29 @@ func TestAccBlockStorageV2Volume_image(t *testing.T) {
 					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_1", &volume),
 					resource.TestCheckResourceAttr(
 						"openstack_blockstorage_volume_v2.volume_1", "name", "volume_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "volume_image_metadata.image_id", OS_IMAGE_ID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV2Volume_schedulerHints(t *testing.T) {
+	var volume1, volume2 volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV2VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV2Volume_schedulerHints,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_1", &volume1),
+					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_2", &volume2),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_2", "scheduler_hints.0.same_host.#", "1"),
 				),
 			},
 		},
@@ -79,6 +137,52 @@ func TestAccBlockStorageV2Volume_timeout(t *testing.T) {
 	})
 }
 
+func TestAccBlockStorageV2Volume_project(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckTenant(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV2VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV2Volume_project,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "project_id", OS_TENANT_ID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV2Volume_defaultMetadata(t *testing.T) {
+	var volume volumes.Volume
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV2VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV2Volume_defaultMetadata,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV2VolumeExists("openstack_blockstorage_volume_v2.volume_1", &volume),
+					testAccCheckBlockStorageV2VolumeMetadata(&volume, "foo", "bar"),
+					testAccCheckBlockStorageV2VolumeMetadata(&volume, "owner", "team_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "metadata.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBlockStorageV2VolumeDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	blockStorageClient, err := config.blockStorageV2Client(OS_REGION_NAME)
@@ -197,6 +301,30 @@ resource "openstack_blockstorage_volume_v2" "volume_1" {
 }
 `
 
+const testAccBlockStorageV2Volume_flags_1 = `
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  metadata {
+    foo = "bar"
+  }
+  size = 1
+  bootable = true
+  read_only = true
+}
+`
+
+const testAccBlockStorageV2Volume_flags_2 = `
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  metadata {
+    foo = "bar"
+  }
+  size = 1
+  bootable = false
+  read_only = false
+}
+`
+
 var testAccBlockStorageV2Volume_image = fmt.Sprintf(`
 resource "openstack_blockstorage_volume_v2" "volume_1" {
   name = "volume_1"
@@ -205,6 +333,22 @@ resource "openstack_blockstorage_volume_v2" "volume_1" {
 }
 `, OS_IMAGE_ID)
 
+const testAccBlockStorageV2Volume_schedulerHints = `
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_v2" "volume_2" {
+  name = "volume_2"
+  size = 1
+
+  scheduler_hints {
+    same_host = ["${openstack_blockstorage_volume_v2.volume_1.id}"]
+  }
+}
+`
+
 const testAccBlockStorageV2Volume_timeout = `
 resource "openstack_blockstorage_volume_v2" "volume_1" {
   name = "volume_1"
@@ -217,3 +361,27 @@ resource "openstack_blockstorage_volume_v2" "volume_1" {
   }
 }
 `
+
+var testAccBlockStorageV2Volume_project = fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 1
+  project_id = "%s"
+}
+`, OS_TENANT_ID)
+
+const testAccBlockStorageV2Volume_defaultMetadata = `
+provider "openstack" {
+  default_metadata {
+    owner = "team_1"
+  }
+}
+
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 1
+  metadata {
+    foo = "bar"
+  }
+}
+`
@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceComputeFlavorAccessV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeFlavorAccessV2Create,
+		Read:   resourceComputeFlavorAccessV2Read,
+		Delete: resourceComputeFlavorAccessV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeFlavorAccessV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	flavorID := d.Get("flavor_id").(string)
+	tenantID := d.Get("tenant_id").(string)
+
+	log.Printf("[DEBUG] Granting project %s access to flavor %s", tenantID, flavorID)
+	if err := computeFlavorAccessV2Add(computeClient, flavorID, tenantID); err != nil {
+		return fmt.Errorf("Error granting project %s access to flavor %s: %s", tenantID, flavorID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", flavorID, tenantID))
+
+	return resourceComputeFlavorAccessV2Read(d, meta)
+}
+
+func resourceComputeFlavorAccessV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	flavorID, tenantID, err := parseComputeFlavorAccessId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	allAccess, err := computeFlavorAccessV2List(computeClient, flavorID)
+	if err != nil {
+		return CheckDeleted(d, err, "flavor_access")
+	}
+
+	found := false
+	for _, access := range allAccess {
+		if access.TenantID == tenantID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Project %s no longer has access to flavor %s", tenantID, flavorID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("flavor_id", flavorID)
+	d.Set("tenant_id", tenantID)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceComputeFlavorAccessV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	flavorID, tenantID, err := parseComputeFlavorAccessId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := computeFlavorAccessV2Remove(computeClient, flavorID, tenantID); err != nil {
+		return CheckDeleted(d, err, "flavor_access")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseComputeFlavorAccessId(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("Unable to determine flavor access ID")
+	}
+
+	flavorID := idParts[0]
+	tenantID := idParts[1]
+
+	return flavorID, tenantID, nil
+}
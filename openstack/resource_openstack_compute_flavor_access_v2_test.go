@@ -0,0 +1,107 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeV2FlavorAccess_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckPrivateFlavor(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2FlavorAccessDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2FlavorAccess_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorAccessExists("openstack_compute_flavor_access_v2.access_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_access_v2.access_1", "flavor_id", OS_PRIVATE_FLAVOR_ID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2FlavorAccessDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	computeClient, err := config.computeV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_compute_flavor_access_v2" {
+			continue
+		}
+
+		flavorID, tenantID, err := parseComputeFlavorAccessId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		allAccess, err := computeFlavorAccessV2List(computeClient, flavorID)
+		if err != nil {
+			continue
+		}
+
+		for _, access := range allAccess {
+			if access.TenantID == tenantID {
+				return fmt.Errorf("Flavor access still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeV2FlavorAccessExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		flavorID, tenantID, err := parseComputeFlavorAccessId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		allAccess, err := computeFlavorAccessV2List(computeClient, flavorID)
+		if err != nil {
+			return err
+		}
+
+		for _, access := range allAccess {
+			if access.TenantID == tenantID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Flavor access not found")
+	}
+}
+
+var testAccComputeV2FlavorAccess_basic = fmt.Sprintf(`
+resource "openstack_compute_flavor_access_v2" "access_1" {
+  flavor_id = "%s"
+  tenant_id = "%s"
+}
+`, OS_PRIVATE_FLAVOR_ID, OS_TENANT_ID)
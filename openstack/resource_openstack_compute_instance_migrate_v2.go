@@ -0,0 +1,208 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// computeInstanceMigrateV2LiveMicroversion is the Compute API version
+	// which lets Nova decide whether a live migration copies the disks.
+	computeInstanceMigrateV2LiveMicroversion = "2.25"
+
+	// computeInstanceMigrateV2HostMicroversion is the Compute API version
+	// which accepts a target host for a cold migration.
+	computeInstanceMigrateV2HostMicroversion = "2.56"
+)
+
+func resourceComputeInstanceMigrateV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInstanceMigrateV2Create,
+		Read:   resourceComputeInstanceMigrateV2Read,
+		Delete: resourceComputeInstanceMigrateV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"live": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"current_host": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInstanceMigrateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	host := d.Get("host").(string)
+	live := d.Get("live").(bool)
+
+	client := *computeClient
+	var action map[string]interface{}
+	if live {
+		client.Microversion = computeInstanceMigrateV2LiveMicroversion
+		liveOpts := map[string]interface{}{
+			"block_migration": "auto",
+			"host":            nil,
+		}
+		if host != "" {
+			liveOpts["host"] = host
+		}
+		action = map[string]interface{}{"os-migrateLive": liveOpts}
+	} else if host != "" {
+		client.Microversion = computeInstanceMigrateV2HostMicroversion
+		action = map[string]interface{}{"migrate": map[string]interface{}{"host": host}}
+	} else {
+		action = map[string]interface{}{"migrate": nil}
+	}
+
+	log.Printf("[DEBUG] Migrating instance %s: %#v", instanceId, action)
+	_, err = client.Post(client.ServiceURL("servers", instanceId, "action"), action, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return fmt.Errorf("Error migrating OpenStack instance %s: %s", instanceId, err)
+	}
+
+	d.SetId(instanceId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"MIGRATING"},
+		Target:     []string{"ACTIVE", "SHUTOFF", "VERIFY_RESIZE"},
+		Refresh:    resourceComputeInstanceMigrateV2RefreshFunc(computeClient, instanceId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	status, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack instance %s to migrate: %s", instanceId, err)
+	}
+
+	// A cold migration is confirmed like a resize, unless the cloud already
+	// did so.
+	if status.(string) == "VERIFY_RESIZE" {
+		log.Printf("[DEBUG] Confirming migration of instance %s", instanceId)
+		err = servers.ConfirmResize(computeClient, instanceId).ExtractErr()
+		if err != nil {
+			if errCode, ok := err.(gophercloud.ErrUnexpectedResponseCode); !ok || errCode.Actual != 409 {
+				return fmt.Errorf("Error confirming migration of OpenStack instance %s: %s", instanceId, err)
+			}
+		}
+
+		stateConf.Pending = []string{"MIGRATING", "VERIFY_RESIZE"}
+		stateConf.Target = []string{"ACTIVE", "SHUTOFF"}
+		if _, err = stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for OpenStack instance %s to confirm migration: %s", instanceId, err)
+		}
+	}
+
+	return resourceComputeInstanceMigrateV2Read(d, meta)
+}
+
+func resourceComputeInstanceMigrateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	var server struct {
+		Host string `json:"OS-EXT-SRV-ATTR:host"`
+	}
+	if err := servers.Get(computeClient, d.Id()).ExtractInto(&server); err != nil {
+		return CheckDeleted(d, err, "instance migration")
+	}
+
+	log.Printf("[DEBUG] Instance %s is on host %q", d.Id(), server.Host)
+
+	d.Set("instance_id", d.Id())
+	d.Set("current_host", server.Host)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+// resourceComputeInstanceMigrateV2Delete only removes the migration from the
+// state. The instance stays on the host it was migrated to.
+func resourceComputeInstanceMigrateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// resourceComputeInstanceMigrateV2RefreshFunc reports an instance as
+// MIGRATING until Nova has no task running on it, since its status only
+// changes once the migration has started.
+func resourceComputeInstanceMigrateV2RefreshFunc(client *gophercloud.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var s struct {
+			Status    string `json:"status"`
+			TaskState string `json:"OS-EXT-STS:task_state"`
+		}
+		if err := servers.Get(client, instanceID).ExtractInto(&s); err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack instance %s status is %s with task %q", instanceID, s.Status, s.TaskState)
+
+		switch s.Status {
+		case "ERROR":
+			return s.Status, s.Status, fmt.Errorf("The instance is in ERROR status")
+		case "MIGRATING", "RESIZE":
+			return s.Status, "MIGRATING", nil
+		}
+
+		if s.TaskState != "" {
+			return s.Status, "MIGRATING", nil
+		}
+
+		return s.Status, s.Status, nil
+	}
+}
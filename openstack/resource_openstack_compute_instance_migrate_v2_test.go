@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeV2InstanceMigrate_cold(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2InstanceMigrate_cold,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "ACTIVE"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_instance_migrate_v2.migrate_1", "current_host"),
+				),
+			},
+		},
+	})
+}
+
+const testAccComputeV2InstanceMigrate_cold = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+resource "openstack_compute_instance_migrate_v2" "migrate_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  live = false
+}
+`
@@ -0,0 +1,191 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceComputeInstanceSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInstanceSnapshotV2Create,
+		Read:   resourceComputeInstanceSnapshotV2Read,
+		Delete: resourceComputeInstanceSnapshotV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"size_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"checksum": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInstanceSnapshotV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+
+	metadata := make(map[string]string)
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		metadata[k] = v.(string)
+	}
+
+	createOpts := servers.CreateImageOpts{
+		Name:     d.Get("name").(string),
+		Metadata: metadata,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	imageId, err := servers.CreateImage(computeClient, instanceId, createOpts).ExtractImageID()
+	if err != nil {
+		return fmt.Errorf("Error creating snapshot of OpenStack instance %s: %s", instanceId, err)
+	}
+	log.Printf("[INFO] Snapshot image ID: %s", imageId)
+
+	// Store the ID now so the image is cleaned up if it fails to build.
+	d.SetId(imageId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"queued", "saving", "importing"},
+		Target:     []string{"active"},
+		Refresh:    resourceComputeInstanceSnapshotV2RefreshFunc(imageClient, imageId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for snapshot %s of OpenStack instance %s to become active: %s", imageId, instanceId, err)
+	}
+
+	return resourceComputeInstanceSnapshotV2Read(d, meta)
+}
+
+func resourceComputeInstanceSnapshotV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	result := images.Get(imageClient, d.Id())
+	img, err := result.Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "instance snapshot")
+	}
+
+	log.Printf("[DEBUG] Retrieved instance snapshot %s: %#v", d.Id(), img)
+
+	// Nova records the instance a snapshot was taken from in the
+	// instance_uuid property of the image.
+	var snapshot struct {
+		InstanceUUID string `json:"instance_uuid"`
+	}
+	if err := result.ExtractInto(&snapshot); err != nil {
+		return fmt.Errorf("Error retrieving instance of snapshot %s: %s", d.Id(), err)
+	}
+	if snapshot.InstanceUUID != "" {
+		d.Set("instance_id", snapshot.InstanceUUID)
+	}
+
+	d.Set("name", img.Name)
+	d.Set("image_id", img.ID)
+	d.Set("size_bytes", img.SizeBytes)
+	d.Set("checksum", img.Checksum)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceComputeInstanceSnapshotV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting instance snapshot %s", d.Id())
+	if err := images.Delete(imageClient, d.Id()).ExtractErr(); err != nil {
+		return CheckDeleted(d, err, "instance snapshot")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceComputeInstanceSnapshotV2RefreshFunc(client *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		img, err := images.Get(client, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack instance snapshot status is: %s", img.Status)
+
+		switch img.Status {
+		case images.ImageStatusKilled, images.ImageStatusDeleted:
+			return img, string(img.Status), fmt.Errorf("The snapshot is in %s status", img.Status)
+		}
+
+		return img, string(img.Status), nil
+	}
+}
@@ -0,0 +1,72 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeV2InstanceSnapshot_basic(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2InstanceSnapshot_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_compute_instance_snapshot_v2.snapshot_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "instance_id",
+						"openstack_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "image_id",
+						"openstack_compute_instance_snapshot_v2.snapshot_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2InstanceSnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_compute_instance_snapshot_v2" {
+			continue
+		}
+
+		_, err := images.Get(imageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Instance snapshot still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccComputeV2InstanceSnapshot_basic = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+resource "openstack_compute_instance_snapshot_v2" "snapshot_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  name = "snapshot_1"
+  metadata {
+    foo = "bar"
+  }
+}
+`
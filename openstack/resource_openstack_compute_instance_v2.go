@@ -7,6 +7,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
@@ -22,11 +26,36 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+const (
+	// computeInstanceV2DeviceTagsMicroversion is the Compute API version used
+	// to create an instance whose networks or block devices have device tags.
+	computeInstanceV2DeviceTagsMicroversion = "2.42"
+
+	// computeInstanceV2DeviceTagsLegacyMicroversion first introduced device
+	// tags. They were dropped in 2.37 and restored in 2.42, so it is only
+	// used by clouds which don't support 2.42 yet.
+	computeInstanceV2DeviceTagsLegacyMicroversion = "2.32"
+
+	// computeInstanceV2BlockDeviceVolumeTypeMicroversion is the Compute API
+	// version which accepts a volume type for the volumes Nova creates for
+	// the block devices of an instance.
+	computeInstanceV2BlockDeviceVolumeTypeMicroversion = "2.67"
+
+	// computeInstanceV2HostnameMicroversion is the Compute API version which
+	// allows the hostname of an instance to be set separately from its name.
+	computeInstanceV2HostnameMicroversion = "2.90"
+
+	// computeInstanceV2LockedMicroversion is the Compute API version which
+	// returns whether an instance is locked.
+	computeInstanceV2LockedMicroversion = "2.9"
+)
+
 func resourceComputeInstanceV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeInstanceV2Create,
@@ -52,6 +81,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Required: true,
 				ForceNew: false,
 			},
+			"hostname": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"image_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -107,6 +141,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"manage_port_security_groups": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"availability_zone": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -138,6 +177,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 							ForceNew: true,
 							Computed: true,
 						},
+						"port_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
 						"fixed_ip_v4": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
@@ -165,6 +209,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"tag": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
 					},
 				},
 			},
@@ -173,10 +222,16 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"ignore_default_metadata": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"config_drive": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
+				Computed: true,
 			},
 			"admin_pass": &schema.Schema{
 				Type:     schema.TypeString,
@@ -241,6 +296,16 @@ func resourceComputeInstanceV2() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"tag": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"volume_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
 					},
 				},
 			},
@@ -306,6 +371,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"additional_properties": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
 					},
 				},
 				Set: resourceComputeSchedulerHintsHash,
@@ -338,6 +408,87 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"locked": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"access_network_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"access_ip_preference": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "floating" && value != "fixed" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'floating' and 'fixed' are supported values for 'access_ip_preference'"))
+					}
+					return
+				},
+			},
+			"power_state": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceComputeInstanceV2ValidatePowerState,
+			},
+			"rescue": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"admin_pass": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"reboot_on_change": resourceInstanceRebootOnChangeSchemaV2(),
+			"wait_for_cloudinit": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"wait_for_ports_active": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"auto_confirm_resize": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"auto_confirm_resize_delay": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateNonNegativeInt,
+			},
+			"vendor_options": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"graceful_shutdown_timeout": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
 			"all_metadata": &schema.Schema{
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -369,6 +520,10 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	// Look for flavor and image settings which are known to conflict, so
+	// that a failure to build the instance can be explained.
+	advisories := computeInstanceV2Advise(computeClient, imageId, flavorId)
+
 	// determine if block_device configuration is correct
 	// this includes valid combinations and required attributes
 	if err := checkBlockDeviceConfig(d); err != nil {
@@ -387,16 +542,29 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	// Look up the ID of the ports given by name.
+	if err := resolveInstancePortNames(config, GetRegion(d), networkDetails); err != nil {
+		return err
+	}
+
 	networks := make([]servers.Network, len(networkDetails))
+	networkTags := make([]string, len(networkDetails))
 	for i, net := range networkDetails {
 		networks[i] = servers.Network{
 			UUID:    net["uuid"].(string),
 			Port:    net["port"].(string),
 			FixedIP: net["fixed_ip_v4"].(string),
 		}
+		networkTags[i] = net["tag"].(string)
 	}
 
-	configDrive := d.Get("config_drive").(bool)
+	// Only request a config drive if one was asked for. Otherwise leave the
+	// decision to the cloud, which may be configured to always use one.
+	var configDrive *bool
+	if v, ok := d.GetOk("config_drive"); ok {
+		cd := v.(bool)
+		configDrive = &cd
+	}
 
 	createOpts = &servers.CreateOpts{
 		Name:             d.Get("name").(string),
@@ -405,8 +573,8 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 		SecurityGroups:   resourceInstanceSecGroupsV2(d),
 		AvailabilityZone: d.Get("availability_zone").(string),
 		Networks:         networks,
-		Metadata:         resourceInstanceMetadataV2(d),
-		ConfigDrive:      &configDrive,
+		Metadata:         MergeDefaultMetadata(config, d.Get("ignore_default_metadata").(bool), resourceInstanceMetadataV2(d)),
+		ConfigDrive:      configDrive,
 		AdminPass:        d.Get("admin_pass").(string),
 		UserData:         []byte(d.Get("user_data").(string)),
 		Personality:      resourceInstancePersonalityV2(d),
@@ -431,6 +599,76 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// Device tags are only accepted by the microversion which restored them,
+	// which must then only be used to create the instance.
+	createClient := computeClient
+	blockDeviceTags := resourceInstanceBlockDeviceTagsV2(d)
+	if resourceInstanceHasDeviceTagsV2(networkTags, blockDeviceTags) {
+		if len(networks) == 0 {
+			return fmt.Errorf("Device tags require at least one network block")
+		}
+
+		microversion, err := resourceInstanceDeviceTagsMicroversionV2(computeClient)
+		if err != nil {
+			return err
+		}
+
+		client := *computeClient
+		client.Microversion = microversion
+		createClient = &client
+
+		createOpts = &InstanceDeviceTagsCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			NetworkTags:       networkTags,
+			BlockDeviceTags:   blockDeviceTags,
+		}
+	}
+
+	// Volume types of block devices need a newer microversion, which also
+	// accepts device tags.
+	blockDeviceVolumeTypes := resourceInstanceBlockDeviceVolumeTypesV2(d)
+	if resourceInstanceHasVolumeTypesV2(blockDeviceVolumeTypes) {
+		if len(networks) == 0 {
+			return fmt.Errorf("Block device volume types require at least one network block")
+		}
+
+		if err := resourceInstanceBlockDeviceVolumeTypeCheckV2(computeClient); err != nil {
+			return err
+		}
+
+		client := *computeClient
+		client.Microversion = computeInstanceV2BlockDeviceVolumeTypeMicroversion
+		createClient = &client
+
+		createOpts = &InstanceBlockDeviceVolumeTypesCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			VolumeTypes:       blockDeviceVolumeTypes,
+		}
+	}
+
+	// The hostname microversion is newer than the ones above, so it replaces
+	// them when a hostname is given.
+	if hostname := d.Get("hostname").(string); hostname != "" {
+		if len(networks) == 0 {
+			return fmt.Errorf("A hostname requires at least one network block")
+		}
+
+		// Personality was removed in the microversion 2.57.
+		if d.Get("personality").(*schema.Set).Len() > 0 {
+			return fmt.Errorf("A hostname can't be used together with personality")
+		}
+
+		createClient, err = resourceInstanceHostnameClientV2(computeClient)
+		if err != nil {
+			return err
+		}
+
+		createOpts = &InstanceHostnameCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			Hostname:          hostname,
+		}
+	}
+
 	schedulerHintsRaw := d.Get("scheduler_hints").(*schema.Set).List()
 	if len(schedulerHintsRaw) > 0 {
 		log.Printf("[DEBUG] schedulerhints: %+v", schedulerHintsRaw)
@@ -447,13 +685,13 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 	// Otherwise, use the normal servers.Create function.
 	var server *servers.Server
 	if _, ok := d.GetOk("block_device"); ok {
-		server, err = bootfromvolume.Create(computeClient, createOpts).Extract()
+		server, err = bootfromvolume.Create(createClient, createOpts).Extract()
 	} else {
-		server, err = servers.Create(computeClient, createOpts).Extract()
+		server, err = servers.Create(createClient, createOpts).Extract()
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error creating OpenStack server: %s", err)
+		return computeInstanceV2AdvisoryError(fmt.Errorf("Error creating OpenStack server: %s", err), advisories)
 	}
 	log.Printf("[INFO] Instance ID: %s", server.ID)
 
@@ -477,9 +715,61 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 
 	_, err = stateConf.WaitForState()
 	if err != nil {
-		return fmt.Errorf(
+		return computeInstanceV2AdvisoryError(fmt.Errorf(
 			"Error waiting for instance (%s) to become ready: %s",
-			server.ID, err)
+			server.ID, err), advisories)
+	}
+
+	// If requested, wait for the ports of the instance to become active.
+	// Neutron only reports a port as active once it is bound and, with
+	// ML2, once its DHCP provisioning has finished.
+	if d.Get("wait_for_ports_active").(bool) {
+		networkingClient, err := config.networkingV2Client(GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		log.Printf("[DEBUG] Waiting for ports of instance (%s) to become active", server.ID)
+
+		portsStateConf := &resource.StateChangeConf{
+			Pending:    []string{"DOWN"},
+			Target:     []string{"ACTIVE"},
+			Refresh:    ServerV2PortsStateRefreshFunc(networkingClient, server.ID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = portsStateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for ports of instance (%s) to become active: %s",
+				server.ID, err)
+		}
+	}
+
+	// If requested, wait for cloud-init to report that it has finished
+	// by watching the console log of the instance.
+	if d.Get("wait_for_cloudinit").(bool) {
+		log.Printf(
+			"[DEBUG] Waiting for cloud-init on instance (%s) to finish",
+			server.ID)
+
+		cloudInitStateConf := &resource.StateChangeConf{
+			Pending:    []string{"RUNNING"},
+			Target:     []string{"FINISHED"},
+			Refresh:    ServerV2CloudInitStateRefreshFunc(computeClient, server.ID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      10 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+
+		_, err = cloudInitStateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for cloud-init on instance (%s) to finish: %s",
+				server.ID, err)
+		}
 	}
 
 	// Now that the instance has been created, we need to do an early read on the
@@ -504,6 +794,32 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.Get("manage_port_security_groups").(bool) && len(resourceInstanceSecGroupsV2(d)) > 0 {
+		if err := setInstancePortSecGroups(config, d); err != nil {
+			return err
+		}
+	}
+
+	// Now that the instance is fully set up, bring it into the requested
+	// power state. Without one, it is left active.
+	if v, ok := d.GetOk("power_state"); ok && v.(string) != "active" {
+		if err := setInstancePowerState(computeClient, d.Id(), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	if rescueOpts, ok := resourceInstanceRescueOptsV2(d); ok {
+		if err := rescueInstance(computeClient, d.Id(), rescueOpts, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("locked").(bool) {
+		if err := serverV2Action(computeClient, d.Id(), "lock"); err != nil {
+			return fmt.Errorf("Error locking OpenStack server (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceComputeInstanceV2Read(d, meta)
 }
 
@@ -514,7 +830,10 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	server, err := servers.Get(computeClient, d.Id()).Extract()
+	// The result is extracted again below for the attributes of extensions,
+	// so the server is only retrieved once.
+	getResult := servers.Get(computeClient, d.Id())
+	server, err := getResult.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "server")
 	}
@@ -523,8 +842,19 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("name", server.Name)
 
+	// The hostname is only returned to non-admin users by the microversion
+	// which allows it to be set. Newer microversions also change the format
+	// of the server, so it is retrieved separately.
+	if d.Get("hostname").(string) != "" {
+		hostname, err := resourceInstanceHostnameV2(computeClient, d.Id())
+		if err != nil {
+			return err
+		}
+		d.Set("hostname", hostname)
+	}
+
 	// Get the instance network and address information
-	networks, err := getInstanceNetworksAndAddresses(computeClient, d)
+	networks, err := getServerNetworksAndAddresses(computeClient, d, server)
 	if err != nil {
 		return err
 	}
@@ -567,8 +897,17 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 	for _, sg := range server.SecurityGroups {
 		secGrpNames = append(secGrpNames, sg["name"].(string))
 	}
+	// Nova merges the security groups of all the ports of the instance, so
+	// compare each port given in the network blocks on its own.
+	expectedSecGrpNames := resourceInstanceSecGroupsV2(d)
 	d.Set("security_groups", secGrpNames)
 
+	if d.Get("manage_port_security_groups").(bool) {
+		if err := readInstancePortSecGroups(config, d, expectedSecGrpNames); err != nil {
+			return err
+		}
+	}
+
 	flavorId, ok := server.Flavor["id"].(string)
 	if !ok {
 		return fmt.Errorf("Error setting OpenStack server's flavor: %v", server.Flavor)
@@ -597,46 +936,153 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 		availabilityzones.ServerExt
 	}
 
-	// Do another Get so the above work is not disturbed.
-	err = servers.Get(computeClient, d.Id()).ExtractInto(&serverWithAZ)
+	err = getResult.ExtractInto(&serverWithAZ)
 	if err != nil {
-		return CheckDeleted(d, err, "server")
+		return fmt.Errorf("Error extracting availability_zone of instance %s: %s", d.Id(), err)
 	}
 
 	// Set the availability zone
 	d.Set("availability_zone", serverWithAZ.AvailabilityZone)
 
+	// Nova reports config_drive as a string such as "True",
+	// or an empty string if the instance has no config drive.
+	var serverWithConfigDrive struct {
+		ConfigDrive string `json:"config_drive"`
+	}
+	if err := getResult.ExtractInto(&serverWithConfigDrive); err != nil {
+		return fmt.Errorf("Error extracting config_drive of instance %s: %s", d.Id(), err)
+	}
+	configDrive, _ := strconv.ParseBool(serverWithConfigDrive.ConfigDrive)
+	d.Set("config_drive", configDrive)
+
+	// Other statuses, e.g. ERROR, are left alone so that they don't cause an
+	// attempt to change the power state.
+	switch server.Status {
+	case "ACTIVE", "SHUTOFF", "SHELVED_OFFLOADED":
+		d.Set("power_state", strings.ToLower(server.Status))
+	case "SHELVED":
+		// Nova offloads shelved instances after a configured delay.
+		d.Set("power_state", "shelved_offloaded")
+	}
+
+	// Only check locked instances, so that an unlock outside of Terraform
+	// shows as a change, without an extra request for every instance.
+	if d.Get("locked").(bool) {
+		locked, err := resourceInstanceLockedV2(computeClient, d.Id())
+		if err != nil {
+			return err
+		}
+		d.Set("locked", locked)
+	}
+
+	// Keep the rescue options in the state while the instance is rescued, so
+	// that only a rescue or unrescue outside of Terraform shows as a change.
+	_, rescued := resourceInstanceRescueOptsV2(d)
+	switch {
+	case server.Status == "RESCUE" && !rescued:
+		d.Set("rescue", []map[string]interface{}{{}})
+	case server.Status != "RESCUE" && rescued:
+		d.Set("rescue", nil)
+	}
+
 	return nil
 }
 
 func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) error {
+	err := resourceComputeInstanceV2ApplyChanges(d, meta)
+	if err == nil {
+		return nil
+	}
+
+	// Terraform only unlocks the instance when locked was set, so explain a
+	// failure caused by a lock set outside of Terraform.
+	if o, _ := d.GetChange("locked"); !o.(bool) {
+		config := meta.(*Config)
+		if computeClient, cerr := config.computeV2Client(GetRegion(d)); cerr == nil {
+			return resourceInstanceLockedErrorV2(computeClient, d.Id(), err)
+		}
+	}
+
+	return err
+}
+
+func resourceComputeInstanceV2ApplyChanges(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	computeClient, err := config.computeV2Client(GetRegion(d))
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	var updateOpts servers.UpdateOpts
+	// A locked instance only accepts changes from admins, so it is unlocked
+	// for the update and locked again at the end.
+	if o, _ := d.GetChange("locked"); o.(bool) {
+		if err := serverV2Action(computeClient, d.Id(), "unlock"); err != nil {
+			return fmt.Errorf("Error unlocking OpenStack server (%s): %s", d.Id(), err)
+		}
+	}
+
+	// An instance has to be unrescued before any other change. A changed
+	// rescue is applied by unrescuing the instance and rescuing it again
+	// once the other changes are done.
+	if o, _ := d.GetChange("rescue"); d.HasChange("rescue") && len(o.([]interface{})) > 0 {
+		if err := unrescueInstance(computeClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	// Start or unshelve the instance first, so that the other changes can be
+	// applied. Shelving is done last.
+	if d.HasChange("power_state") && d.Get("power_state").(string) != "shelved_offloaded" {
+		if err := setInstancePowerState(computeClient, d.Id(), d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	var updateOpts InstanceUpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
 
-	if updateOpts != (servers.UpdateOpts{}) {
-		_, err := servers.Update(computeClient, d.Id(), updateOpts).Extract()
+	// A removed hostname is kept, since an instance always has one.
+	updateClient := computeClient
+	if hostname := d.Get("hostname").(string); d.HasChange("hostname") && hostname != "" {
+		updateClient, err = resourceInstanceHostnameClientV2(computeClient)
+		if err != nil {
+			return err
+		}
+		updateOpts.Hostname = hostname
+	}
+
+	if updateOpts != (InstanceUpdateOpts{}) {
+		_, err := servers.Update(updateClient, d.Id(), updateOpts).Extract()
 		if err != nil {
 			return fmt.Errorf("Error updating OpenStack server: %s", err)
 		}
 	}
 
-	if d.HasChange("metadata") {
-		oldMetadata, newMetadata := d.GetChange("metadata")
+	if d.HasChange("metadata") || d.HasChange("ignore_default_metadata") {
+		oldMetadataRaw, newMetadataRaw := d.GetChange("metadata")
+		oldIgnore, newIgnore := d.GetChange("ignore_default_metadata")
+
+		oldMetadata := make(map[string]string)
+		for k, v := range oldMetadataRaw.(map[string]interface{}) {
+			oldMetadata[k] = v.(string)
+		}
+		oldMetadata = MergeDefaultMetadata(config, oldIgnore.(bool), oldMetadata)
+
+		newMetadata := make(map[string]string)
+		for k, v := range newMetadataRaw.(map[string]interface{}) {
+			newMetadata[k] = v.(string)
+		}
+		newMetadata = MergeDefaultMetadata(config, newIgnore.(bool), newMetadata)
+
 		var metadataToDelete []string
 
 		// Determine if any metadata keys were removed from the configuration.
 		// Then request those keys to be deleted.
-		for oldKey, _ := range oldMetadata.(map[string]interface{}) {
+		for oldKey, _ := range oldMetadata {
 			var found bool
-			for newKey, _ := range newMetadata.(map[string]interface{}) {
+			for newKey, _ := range newMetadata {
 				if oldKey == newKey {
 					found = true
 				}
@@ -656,8 +1102,8 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 
 		// Update existing metadata and add any new metadata.
 		metadataOpts := make(servers.MetadataOpts)
-		for k, v := range newMetadata.(map[string]interface{}) {
-			metadataOpts[k] = v.(string)
+		for k, v := range newMetadata {
+			metadataOpts[k] = v
 		}
 
 		_, err := servers.UpdateMetadata(computeClient, d.Id(), metadataOpts).Extract()
@@ -699,6 +1145,17 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.Get("manage_port_security_groups").(bool) {
+		if d.HasChange("security_groups") || d.HasChange("manage_port_security_groups") {
+			if err := setInstancePortSecGroups(config, d); err != nil {
+				return err
+			}
+		}
+	} else if d.HasChange("security_groups") && resourceInstanceHasPortsV2(d) {
+		log.Printf("[WARN] The security groups of the ports given in the network blocks of "+
+			"instance (%s) are not changed, unless manage_port_security_groups is set", d.Id())
+	}
+
 	if d.HasChange("admin_pass") {
 		if newPwd, ok := d.Get("admin_pass").(string); ok {
 			err := servers.ChangeAdminPassword(computeClient, d.Id(), newPwd).ExtractErr()
@@ -814,42 +1271,37 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("Error resizing OpenStack server: %s", err)
 		}
 
-		// Wait for the instance to finish resizing.
-		log.Printf("[DEBUG] Waiting for instance (%s) to finish resizing", d.Id())
-
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{"RESIZE"},
-			Target:     []string{"VERIFY_RESIZE"},
-			Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+		autoConfirm := d.Get("auto_confirm_resize").(bool)
+		confirmDelay := time.Duration(d.Get("auto_confirm_resize_delay").(int)) * time.Second
+		if err := confirmInstanceResize(computeClient, d.Id(), newFlavorId, autoConfirm, confirmDelay, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
+	}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf("Error waiting for instance (%s) to resize: %s", d.Id(), err)
+	if method, ok := resourceInstanceRebootOnChangeV2(d); ok {
+		powerState := d.Get("power_state").(string)
+		if _, rescue := resourceInstanceRescueOptsV2(d); (powerState != "" && powerState != "active") || rescue {
+			log.Printf("[DEBUG] Not rebooting instance (%s), since it won't be active", d.Id())
+		} else if err := rebootInstance(computeClient, d.Id(), method, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
+	}
 
-		// Confirm resize.
-		log.Printf("[DEBUG] Confirming resize")
-		err = servers.ConfirmResize(computeClient, d.Id()).ExtractErr()
-		if err != nil {
-			return fmt.Errorf("Error confirming resize of OpenStack server: %s", err)
+	if d.HasChange("power_state") && d.Get("power_state").(string) == "shelved_offloaded" {
+		if err := setInstancePowerState(computeClient, d.Id(), "shelved_offloaded", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
+	}
 
-		stateConf = &resource.StateChangeConf{
-			Pending:    []string{"VERIFY_RESIZE"},
-			Target:     []string{"ACTIVE"},
-			Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+	if rescueOpts, ok := resourceInstanceRescueOptsV2(d); ok && d.HasChange("rescue") {
+		if err := rescueInstance(computeClient, d.Id(), rescueOpts, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
+	}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf("Error waiting for instance (%s) to confirm resize: %s", d.Id(), err)
+	if d.Get("locked").(bool) {
+		if err := serverV2Action(computeClient, d.Id(), "lock"); err != nil {
+			return fmt.Errorf("Error locking OpenStack server (%s): %s", d.Id(), err)
 		}
 	}
 
@@ -863,6 +1315,12 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
+	if d.Get("locked").(bool) {
+		if err := serverV2Action(computeClient, d.Id(), "unlock"); err != nil {
+			return CheckDeleted(d, err, "server")
+		}
+	}
+
 	// Make sure all volumes are detached before deleting
 	volumes := d.Get("volume")
 	if volumeSet, ok := volumes.(*schema.Set); ok {
@@ -879,7 +1337,20 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	var stopTimeout time.Duration
 	if d.Get("stop_before_destroy").(bool) {
+		stopTimeout = 3 * time.Minute
+	}
+	if gracefulTimeout := resourceInstanceGracefulShutdownTimeoutV2(d); gracefulTimeout > 0 {
+		stopTimeout = gracefulTimeout
+	}
+
+	// A rescued instance can't be stopped, it is deleted as it is.
+	if _, rescued := resourceInstanceRescueOptsV2(d); rescued {
+		stopTimeout = 0
+	}
+
+	if stopTimeout > 0 {
 		err = startstop.Stop(computeClient, d.Id()).ExtractErr()
 		if err != nil {
 			log.Printf("[WARN] Error stopping OpenStack instance: %s", err)
@@ -888,7 +1359,7 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 				Pending:    []string{"ACTIVE"},
 				Target:     []string{"SHUTOFF"},
 				Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
-				Timeout:    3 * time.Minute,
+				Timeout:    stopTimeout,
 				Delay:      10 * time.Second,
 				MinTimeout: 3 * time.Second,
 			}
@@ -903,22 +1374,23 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 	if d.Get("force_delete").(bool) {
 		log.Printf("[DEBUG] Force deleting OpenStack Instance %s", d.Id())
 		err = servers.ForceDelete(computeClient, d.Id()).ExtractErr()
-		if err != nil {
-			return fmt.Errorf("Error deleting OpenStack server: %s", err)
-		}
 	} else {
 		log.Printf("[DEBUG] Deleting OpenStack Instance %s", d.Id())
 		err = servers.Delete(computeClient, d.Id()).ExtractErr()
-		if err != nil {
-			return fmt.Errorf("Error deleting OpenStack server: %s", err)
+	}
+	if err != nil {
+		err = fmt.Errorf("Error deleting OpenStack server: %s", err)
+		if !d.Get("locked").(bool) {
+			err = resourceInstanceLockedErrorV2(computeClient, d.Id(), err)
 		}
+		return err
 	}
 
 	// Wait for the instance to delete before moving on.
 	log.Printf("[DEBUG] Waiting for instance (%s) to delete", d.Id())
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"ACTIVE", "SHUTOFF"},
+		Pending:    []string{"ACTIVE", "SHUTOFF", "SHELVED", "SHELVED_OFFLOADED", "RESCUE"},
 		Target:     []string{"DELETED", "SOFT_DELETED"},
 		Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
 		Timeout:    d.Timeout(schema.TimeoutDelete),
@@ -953,14 +1425,136 @@ func ServerV2StateRefreshFunc(client *gophercloud.ServiceClient, instanceID stri
 	}
 }
 
-func resourceInstanceSecGroupsV2(d *schema.ResourceData) []string {
-	rawSecGroups := d.Get("security_groups").(*schema.Set).List()
-	secgroups := make([]string, len(rawSecGroups))
-	for i, raw := range rawSecGroups {
-		secgroups[i] = raw.(string)
-	}
-	return secgroups
-}
+// ServerV2ResizeStateRefreshFunc returns a resource.StateRefreshFunc that is
+// used to watch an OpenStack instance being resized to the given flavor. An
+// instance which is still running with its old flavor is reported as RESIZE
+// while it has a task, so an ACTIVE or SHUTOFF status means the resize was
+// confirmed. Without a task, the resize failed, e.g. because no host could
+// take the new flavor, and the instance was left as it was.
+func ServerV2ResizeStateRefreshFunc(client *gophercloud.ServiceClient, instanceID, flavorID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		result := servers.Get(client, instanceID)
+		s, err := result.Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch s.Status {
+		case "ACTIVE", "SHUTOFF":
+			if id, _ := s.Flavor["id"].(string); id != flavorID {
+				var server struct {
+					TaskState *string `json:"OS-EXT-STS:task_state"`
+					Fault     struct {
+						Message string `json:"message"`
+					} `json:"fault"`
+				}
+				if err := result.ExtractInto(&server); err != nil {
+					return nil, "", err
+				}
+
+				if server.TaskState != nil {
+					return s, "RESIZE", nil
+				}
+				if server.Fault.Message != "" {
+					return s, "", fmt.Errorf("Instance %s failed to resize: %s", instanceID, server.Fault.Message)
+				}
+				return s, "", fmt.Errorf("Instance %s failed to resize and kept its old flavor", instanceID)
+			}
+		}
+
+		return s, s.Status, nil
+	}
+}
+
+// cloudInitFinishedRegexp matches the line cloud-init writes to the console
+// once all of its stages have completed, for example:
+// "Cloud-init v. 0.7.9 finished at Mon, 15 May 2017 10:00:00 +0000."
+var cloudInitFinishedRegexp = regexp.MustCompile(`Cloud-init v\. \S+ finished at`)
+
+// ServerV2CloudInitStateRefreshFunc returns a resource.StateRefreshFunc that is
+// used to watch the console log of an OpenStack instance for cloud-init completion.
+func ServerV2CloudInitStateRefreshFunc(client *gophercloud.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := getServerConsoleOutput(client, instanceID, 50)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if cloudInitFinishedRegexp.MatchString(output) {
+			return output, "FINISHED", nil
+		}
+
+		return output, "RUNNING", nil
+	}
+}
+
+// ServerV2PortsStateRefreshFunc returns a resource.StateRefreshFunc that is
+// used to watch the Neutron ports of an OpenStack instance. Ports which are
+// administratively down never become active and are skipped. It is used once
+// the instance is ACTIVE, when Nova has created all of its ports, so an
+// instance without ports has nothing to wait for.
+func ServerV2PortsStateRefreshFunc(networkingClient *gophercloud.ServiceClient, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		allPages, err := ports.List(networkingClient, ports.ListOpts{DeviceID: instanceID}).AllPages()
+		if err != nil {
+			return nil, "", err
+		}
+
+		allPorts, err := ports.ExtractPorts(allPages)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, port := range allPorts {
+			if !port.AdminStateUp {
+				continue
+			}
+
+			log.Printf("[DEBUG] Port %s of instance %s is %s", port.ID, instanceID, port.Status)
+
+			switch port.Status {
+			case "ACTIVE":
+			case "ERROR":
+				return allPorts, port.Status, fmt.Errorf("Port %s is in ERROR status", port.ID)
+			default:
+				return allPorts, "DOWN", nil
+			}
+		}
+
+		return allPorts, "ACTIVE", nil
+	}
+}
+
+// getServerConsoleOutput retrieves the last length lines of the console log
+// of an instance. A length of 0 retrieves the whole log.
+func getServerConsoleOutput(client *gophercloud.ServiceClient, instanceID string, length int) (string, error) {
+	consoleOpts := make(map[string]interface{})
+	if length > 0 {
+		consoleOpts["length"] = length
+	}
+	reqBody := map[string]interface{}{"os-getConsoleOutput": consoleOpts}
+
+	var res struct {
+		Output string `json:"output"`
+	}
+	_, err := client.Post(client.ServiceURL("servers", instanceID, "action"), reqBody, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return res.Output, nil
+}
+
+func resourceInstanceSecGroupsV2(d *schema.ResourceData) []string {
+	rawSecGroups := d.Get("security_groups").(*schema.Set).List()
+	secgroups := make([]string, len(rawSecGroups))
+	for i, raw := range rawSecGroups {
+		secgroups[i] = raw.(string)
+	}
+	return secgroups
+}
 
 // getInstanceNetworks collects instance network information from different sources
 // and aggregates it all together.
@@ -971,6 +1565,12 @@ func getInstanceNetworksAndAddresses(computeClient *gophercloud.ServiceClient, d
 		return nil, CheckDeleted(d, err, "server")
 	}
 
+	return getServerNetworksAndAddresses(computeClient, d, server)
+}
+
+// getServerNetworksAndAddresses is getInstanceNetworksAndAddresses for an
+// instance which was already retrieved.
+func getServerNetworksAndAddresses(computeClient *gophercloud.ServiceClient, d *schema.ResourceData, server *servers.Server) ([]map[string]interface{}, error) {
 	networkDetails, err := getInstanceNetworks(computeClient, d)
 	addresses := getInstanceAddresses(server.Addresses)
 	if err != nil {
@@ -1004,11 +1604,13 @@ func getInstanceNetworksAndAddresses(computeClient *gophercloud.ServiceClient, d
 				"uuid":           networkDetails[i]["uuid"],
 				"name":           networkDetails[i]["name"],
 				"port":           networkDetails[i]["port"],
+				"port_name":      networkDetails[i]["port_name"],
 				"fixed_ip_v4":    n["fixed_ip_v4"],
 				"fixed_ip_v6":    n["fixed_ip_v6"],
 				"floating_ip":    n["floating_ip"],
 				"mac":            n["mac"],
 				"access_network": networkDetails[i]["access_network"],
+				"tag":            networkDetails[i]["tag"],
 			}
 		}
 	}
@@ -1021,28 +1623,12 @@ func getInstanceNetworksAndAddresses(computeClient *gophercloud.ServiceClient, d
 func getInstanceNetworks(computeClient *gophercloud.ServiceClient, d *schema.ResourceData) ([]map[string]interface{}, error) {
 	rawNetworks := d.Get("network").([]interface{})
 	newNetworks := make([]map[string]interface{}, 0, len(rawNetworks))
-	var tenantnet tenantnetworks.Network
 
+	// The tenant networks are the same for every network block, so they are
+	// only listed once.
 	tenantNetworkExt := true
-	for _, raw := range rawNetworks {
-		// Not sure what causes this, but it is a possibility (see GH-2323).
-		// Since we call this function to reconcile what we'll save in the
-		// state anyways, we just ignore it.
-		if raw == nil {
-			continue
-		}
-
-		rawMap := raw.(map[string]interface{})
-
-		// Both a floating IP and a port cannot be specified
-		if fip, ok := rawMap["floating_ip"].(string); ok {
-			if port, ok := rawMap["port"].(string); ok {
-				if fip != "" && port != "" {
-					return nil, fmt.Errorf("Only one of a floating IP or port may be specified per network.")
-				}
-			}
-		}
-
+	var networkList []tenantnetworks.Network
+	if len(rawNetworks) > 0 {
 		allPages, err := tenantnetworks.List(computeClient).AllPages()
 		if err != nil {
 			if _, ok := err.(gophercloud.ErrDefault404); ok {
@@ -1064,7 +1650,6 @@ func getInstanceNetworks(computeClient *gophercloud.ServiceClient, d *schema.Res
 
 		// In some cases, a call to os-tenant-networks might work,
 		// but the response is invalid. Catch this during extraction.
-		networkList := []tenantnetworks.Network{}
 		if tenantNetworkExt {
 			networkList, err = tenantnetworks.ExtractNetworks(allPages)
 			if err != nil {
@@ -1072,15 +1657,43 @@ func getInstanceNetworks(computeClient *gophercloud.ServiceClient, d *schema.Res
 				tenantNetworkExt = false
 			}
 		}
+	}
+
+	for _, raw := range rawNetworks {
+		// Not sure what causes this, but it is a possibility (see GH-2323).
+		// Since we call this function to reconcile what we'll save in the
+		// state anyways, we just ignore it.
+		if raw == nil {
+			continue
+		}
+
+		rawMap := raw.(map[string]interface{})
+
+		// Both a floating IP and a port cannot be specified
+		if fip, ok := rawMap["floating_ip"].(string); ok {
+			if port, ok := rawMap["port"].(string); ok {
+				if fip != "" && port != "" {
+					return nil, fmt.Errorf("Only one of a floating IP or port may be specified per network.")
+				}
+			}
+			if portName, ok := rawMap["port_name"].(string); ok {
+				if fip != "" && portName != "" {
+					return nil, fmt.Errorf("Only one of a floating IP or port_name may be specified per network.")
+				}
+			}
+		}
 
+		// A network given only by its port has no uuid or name to look up,
+		// and must not match an unnamed tenant network.
 		networkID := ""
 		networkName := ""
 		if tenantNetworkExt {
+			var tenantnet tenantnetworks.Network
 			for _, network := range networkList {
-				if network.Name == rawMap["name"] {
+				if rawMap["name"].(string) != "" && network.Name == rawMap["name"] {
 					tenantnet = network
 				}
-				if network.ID == rawMap["uuid"] {
+				if rawMap["uuid"].(string) != "" && network.ID == rawMap["uuid"] {
 					tenantnet = network
 				}
 			}
@@ -1096,8 +1709,10 @@ func getInstanceNetworks(computeClient *gophercloud.ServiceClient, d *schema.Res
 			"uuid":           networkID,
 			"name":           networkName,
 			"port":           rawMap["port"].(string),
+			"port_name":      rawMap["port_name"].(string),
 			"fixed_ip_v4":    rawMap["fixed_ip_v4"].(string),
 			"access_network": rawMap["access_network"].(bool),
+			"tag":            rawMap["tag"].(string),
 		})
 	}
 
@@ -1105,6 +1720,60 @@ func getInstanceNetworks(computeClient *gophercloud.ServiceClient, d *schema.Res
 	return newNetworks, nil
 }
 
+// resolveInstancePortNames sets the port of the networks which specify a
+// port_name to the ID of the port with that name. The ports are only looked
+// up on the network of the block when it has a uuid or name.
+func resolveInstancePortNames(config *Config, region string, networkDetails []map[string]interface{}) error {
+	var networkingClient *gophercloud.ServiceClient
+	for _, net := range networkDetails {
+		portName := net["port_name"].(string)
+		if portName == "" {
+			continue
+		}
+
+		if net["port"].(string) != "" {
+			return fmt.Errorf("Only one of port or port_name may be specified per network.")
+		}
+
+		if networkingClient == nil {
+			var err error
+			networkingClient, err = config.networkingV2Client(region)
+			if err != nil {
+				return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+			}
+		}
+
+		listOpts := ports.ListOpts{
+			Name:      portName,
+			NetworkID: net["uuid"].(string),
+		}
+
+		allPages, err := ports.List(networkingClient, listOpts).AllPages()
+		if err != nil {
+			return fmt.Errorf("Unable to list ports named %s: %s", portName, err)
+		}
+
+		allPorts, err := ports.ExtractPorts(allPages)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve ports named %s: %s", portName, err)
+		}
+
+		if len(allPorts) < 1 {
+			return fmt.Errorf("No port named %s was found.", portName)
+		}
+
+		if len(allPorts) > 1 {
+			return fmt.Errorf("More than one port named %s was found. "+
+				"Please use the port ID instead.", portName)
+		}
+
+		log.Printf("[DEBUG] Found port %s for port_name %s", allPorts[0].ID, portName)
+		net["port"] = allPorts[0].ID
+	}
+
+	return nil
+}
+
 func getInstanceAddresses(addresses map[string]interface{}) map[string]map[string]interface{} {
 	addrs := make(map[string]map[string]interface{})
 	for n, networkAddresses := range addresses {
@@ -1134,6 +1803,8 @@ func getInstanceAddresses(addresses map[string]interface{}) map[string]map[strin
 func getInstanceAccessAddresses(d *schema.ResourceData, networks []map[string]interface{}) (string, string) {
 	var hostv4, hostv6 string
 
+	accessNetworkName := d.Get("access_network_name").(string)
+
 	// Start with a global floating IP
 	floatingIP := d.Get("floating_ip").(string)
 	if floatingIP != "" {
@@ -1144,6 +1815,9 @@ func getInstanceAccessAddresses(d *schema.ResourceData, networks []map[string]in
 	// If the network has a valid floating, fixed v4, or fixed v6 address
 	// and hostv4 or hostv6 is not set, set hostv4/hostv6.
 	// If the network is an "access_network" overwrite hostv4/hostv6.
+	// The fixed and floating IPv4 addresses are also tracked separately
+	// for access_ip_preference.
+	fixedv4, floatingv4 := "", floatingIP
 	for _, n := range networks {
 		var accessNetwork bool
 
@@ -1151,16 +1825,26 @@ func getInstanceAccessAddresses(d *schema.ResourceData, networks []map[string]in
 			accessNetwork = true
 		}
 
+		if name, ok := n["name"].(string); ok && accessNetworkName != "" && name == accessNetworkName {
+			accessNetwork = true
+		}
+
 		if fixedIPv4, ok := n["fixed_ip_v4"].(string); ok && fixedIPv4 != "" {
 			if hostv4 == "" || accessNetwork {
 				hostv4 = fixedIPv4
 			}
+			if fixedv4 == "" || accessNetwork {
+				fixedv4 = fixedIPv4
+			}
 		}
 
 		if floatingIP, ok := n["floating_ip"].(string); ok && floatingIP != "" {
 			if hostv4 == "" || accessNetwork {
 				hostv4 = floatingIP
 			}
+			if floatingv4 == "" || accessNetwork {
+				floatingv4 = floatingIP
+			}
 		}
 
 		if fixedIPv6, ok := n["fixed_ip_v6"].(string); ok && fixedIPv6 != "" {
@@ -1170,6 +1854,17 @@ func getInstanceAccessAddresses(d *schema.ResourceData, networks []map[string]in
 		}
 	}
 
+	switch d.Get("access_ip_preference").(string) {
+	case "fixed":
+		if fixedv4 != "" {
+			hostv4 = fixedv4
+		}
+	case "floating":
+		if floatingv4 != "" {
+			hostv4 = floatingv4
+		}
+	}
+
 	log.Printf("[DEBUG] OpenStack Instance Network Access Addresses: %s, %s", hostv4, hostv6)
 
 	return hostv4, hostv6
@@ -1255,6 +1950,18 @@ func resourceInstanceMetadataV2(d *schema.ResourceData) map[string]string {
 	return m
 }
 
+// resourceInstanceGracefulShutdownTimeoutV2 returns how long to wait for the
+// guest to shut down cleanly before the instance is deleted.
+func resourceInstanceGracefulShutdownTimeoutV2(d *schema.ResourceData) time.Duration {
+	vendorOptionsRaw := d.Get("vendor_options").([]interface{})
+	if len(vendorOptionsRaw) == 0 || vendorOptionsRaw[0] == nil {
+		return 0
+	}
+
+	vendorOptions := vendorOptionsRaw[0].(map[string]interface{})
+	return time.Duration(vendorOptions["graceful_shutdown_timeout"].(int)) * time.Second
+}
+
 func resourceInstanceBlockDevicesV2(d *schema.ResourceData, bds []interface{}) ([]bootfromvolume.BlockDevice, error) {
 	blockDeviceOpts := make([]bootfromvolume.BlockDevice, len(bds))
 	for i, bd := range bds {
@@ -1296,7 +2003,156 @@ func resourceInstanceBlockDevicesV2(d *schema.ResourceData, bds []interface{}) (
 	return blockDeviceOpts, nil
 }
 
-func resourceInstanceSchedulerHintsV2(d *schema.ResourceData, schedulerHintsRaw map[string]interface{}) schedulerhints.SchedulerHints {
+func resourceInstanceBlockDeviceTagsV2(d *schema.ResourceData) []string {
+	bds := d.Get("block_device").([]interface{})
+	tags := make([]string, len(bds))
+	for i, bd := range bds {
+		if bdM, ok := bd.(map[string]interface{}); ok {
+			tags[i] = bdM["tag"].(string)
+		}
+	}
+
+	return tags
+}
+
+func resourceInstanceBlockDeviceVolumeTypesV2(d *schema.ResourceData) []string {
+	bds := d.Get("block_device").([]interface{})
+	volumeTypes := make([]string, len(bds))
+	for i, bd := range bds {
+		if bdM, ok := bd.(map[string]interface{}); ok {
+			volumeTypes[i] = bdM["volume_type"].(string)
+		}
+	}
+
+	return volumeTypes
+}
+
+func resourceInstanceHasVolumeTypesV2(volumeTypes []string) bool {
+	for _, volumeType := range volumeTypes {
+		if volumeType != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resourceInstanceBlockDeviceVolumeTypeCheckV2 makes sure the cloud accepts
+// volume types for block devices. If the versions supported by the cloud
+// can't be determined, the instance creation is left to report it.
+func resourceInstanceBlockDeviceVolumeTypeCheckV2(computeClient *gophercloud.ServiceClient) error {
+	maxMicroversion, err := computeV2MaxMicroversion(computeClient)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine the Compute API versions: %s", err)
+		return nil
+	}
+
+	if !computeV2MicroversionAtLeast(maxMicroversion, computeInstanceV2BlockDeviceVolumeTypeMicroversion) {
+		return fmt.Errorf("Block device volume types require the Compute API microversion %s, "+
+			"but the cloud only supports up to %s", computeInstanceV2BlockDeviceVolumeTypeMicroversion, maxMicroversion)
+	}
+
+	return nil
+}
+
+// resourceInstanceHostnameClientV2 returns a copy of the compute client which
+// uses the microversion that allows the hostname of an instance to be set.
+func resourceInstanceHostnameClientV2(computeClient *gophercloud.ServiceClient) (*gophercloud.ServiceClient, error) {
+	maxMicroversion, err := computeV2MaxMicroversion(computeClient)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine the Compute API versions: %s", err)
+	} else if !computeV2MicroversionAtLeast(maxMicroversion, computeInstanceV2HostnameMicroversion) {
+		return nil, fmt.Errorf("A hostname requires the Compute API microversion %s, "+
+			"but the cloud only supports up to %s", computeInstanceV2HostnameMicroversion, maxMicroversion)
+	}
+
+	client := *computeClient
+	client.Microversion = computeInstanceV2HostnameMicroversion
+
+	return &client, nil
+}
+
+func resourceInstanceHostnameV2(computeClient *gophercloud.ServiceClient, id string) (string, error) {
+	client := *computeClient
+	client.Microversion = computeInstanceV2HostnameMicroversion
+
+	var server struct {
+		Hostname string `json:"OS-EXT-SRV-ATTR:hostname"`
+	}
+	if err := servers.Get(&client, id).ExtractInto(&server); err != nil {
+		return "", fmt.Errorf("Error retrieving hostname of OpenStack instance %s: %s", id, err)
+	}
+
+	return server.Hostname, nil
+}
+
+func resourceInstanceLockedV2(computeClient *gophercloud.ServiceClient, id string) (bool, error) {
+	client := *computeClient
+	client.Microversion = computeInstanceV2LockedMicroversion
+
+	var server struct {
+		Locked bool `json:"locked"`
+	}
+	if err := servers.Get(&client, id).ExtractInto(&server); err != nil {
+		return false, fmt.Errorf("Error retrieving lock of OpenStack instance %s: %s", id, err)
+	}
+
+	return server.Locked, nil
+}
+
+// resourceInstanceLockedErrorV2 adds an explanation to err, returned by a
+// change of an instance, when the instance turns out to be locked. Nova
+// refuses most changes of a locked instance from non-admin users with an
+// unhelpful conflict error.
+func resourceInstanceLockedErrorV2(computeClient *gophercloud.ServiceClient, id string, err error) error {
+	locked, lerr := resourceInstanceLockedV2(computeClient, id)
+	if lerr != nil {
+		log.Printf("[DEBUG] Unable to check whether OpenStack instance %s is locked: %s", id, lerr)
+		return err
+	}
+
+	if !locked {
+		return err
+	}
+
+	return fmt.Errorf("%s\n\nOpenStack instance %s is locked, so only admins can change or delete it. "+
+		"Unlock it, or set locked to true so that Terraform unlocks it while applying changes.", err, id)
+}
+
+// resourceInstanceDeviceTagsMicroversionV2 returns the Compute API version to
+// use to create an instance with device tags. If the versions supported by
+// the cloud can't be determined, the one which restored the tags is used.
+func resourceInstanceDeviceTagsMicroversionV2(computeClient *gophercloud.ServiceClient) (string, error) {
+	maxMicroversion, err := computeV2MaxMicroversion(computeClient)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine the Compute API versions: %s", err)
+		return computeInstanceV2DeviceTagsMicroversion, nil
+	}
+
+	switch {
+	case computeV2MicroversionAtLeast(maxMicroversion, computeInstanceV2DeviceTagsMicroversion):
+		return computeInstanceV2DeviceTagsMicroversion, nil
+	case computeV2MicroversionAtLeast(maxMicroversion, computeInstanceV2DeviceTagsLegacyMicroversion):
+		return computeInstanceV2DeviceTagsLegacyMicroversion, nil
+	}
+
+	return "", fmt.Errorf("Device tags require the Compute API microversion %s, "+
+		"but the cloud only supports up to %s", computeInstanceV2DeviceTagsLegacyMicroversion, maxMicroversion)
+}
+
+func resourceInstanceHasDeviceTagsV2(tagLists ...[]string) bool {
+	for _, tags := range tagLists {
+		for _, tag := range tags {
+			if tag != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func resourceInstanceSchedulerHintsV2(d *schema.ResourceData, schedulerHintsRaw map[string]interface{}) InstanceSchedulerHints {
 	differentHost := []string{}
 	if len(schedulerHintsRaw["different_host"].([]interface{})) > 0 {
 		for _, dh := range schedulerHintsRaw["different_host"].([]interface{}) {
@@ -1327,7 +2183,12 @@ func resourceInstanceSchedulerHintsV2(d *schema.ResourceData, schedulerHintsRaw
 		BuildNearHostIP: schedulerHintsRaw["build_near_host_ip"].(string),
 	}
 
-	return schedulerHints
+	additionalProperties, _ := schedulerHintsRaw["additional_properties"].(map[string]interface{})
+
+	return InstanceSchedulerHints{
+		SchedulerHints:       schedulerHints,
+		AdditionalProperties: additionalProperties,
+	}
 }
 
 func getImageIDFromConfig(computeClient *gophercloud.ServiceClient, d *schema.ResourceData) (string, error) {
@@ -1450,6 +2311,17 @@ func resourceComputeSchedulerHintsHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["same_host"].([]interface{})))
 	buf.WriteString(fmt.Sprintf("%s-", m["query"].([]interface{})))
 
+	if v, ok := m["additional_properties"].(map[string]interface{}); ok {
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			buf.WriteString(fmt.Sprintf("%s=%s-", k, v[k]))
+		}
+	}
+
 	return hashcode.String(buf.String())
 }
 
@@ -1624,3 +2496,238 @@ func resourceInstancePersonalityV2(d *schema.ResourceData) servers.Personality {
 
 	return personalities
 }
+
+func resourceComputeInstanceV2ValidatePowerState(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "active", "shutoff", "shelved_offloaded":
+		return
+	}
+	errors = append(errors, fmt.Errorf("Only 'active', 'shutoff' and 'shelved_offloaded' are supported values for %q", k))
+	return
+}
+
+// setInstancePowerState brings an instance into the given power state by
+// starting, stopping, shelving or unshelving it as needed.
+func setInstancePowerState(client *gophercloud.ServiceClient, instanceID, powerState string, timeout time.Duration) error {
+	server, err := servers.Get(client, instanceID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenStack server (%s): %s", instanceID, err)
+	}
+
+	status := server.Status
+	log.Printf("[DEBUG] Changing power state of instance (%s) from %s to %s", instanceID, status, powerState)
+
+	// Stopped and shelved instances have to be brought up first.
+	if powerState != "shelved_offloaded" && (status == "SHELVED" || status == "SHELVED_OFFLOADED") {
+		if err := serverV2Action(client, instanceID, "unshelve"); err != nil {
+			return fmt.Errorf("Error unshelving OpenStack server (%s): %s", instanceID, err)
+		}
+		if err := waitForServerV2Status(client, instanceID, []string{"SHELVED", "SHELVED_OFFLOADED"}, "ACTIVE", timeout); err != nil {
+			return err
+		}
+		status = "ACTIVE"
+	}
+
+	switch powerState {
+	case "active":
+		if status == "SHUTOFF" {
+			if err := startstop.Start(client, instanceID).ExtractErr(); err != nil {
+				return fmt.Errorf("Error starting OpenStack server (%s): %s", instanceID, err)
+			}
+			return waitForServerV2Status(client, instanceID, []string{"SHUTOFF"}, "ACTIVE", timeout)
+		}
+	case "shutoff":
+		if status == "ACTIVE" {
+			if err := startstop.Stop(client, instanceID).ExtractErr(); err != nil {
+				return fmt.Errorf("Error stopping OpenStack server (%s): %s", instanceID, err)
+			}
+			return waitForServerV2Status(client, instanceID, []string{"ACTIVE"}, "SHUTOFF", timeout)
+		}
+	case "shelved_offloaded":
+		if status == "ACTIVE" || status == "SHUTOFF" {
+			if err := serverV2Action(client, instanceID, "shelve"); err != nil {
+				return fmt.Errorf("Error shelving OpenStack server (%s): %s", instanceID, err)
+			}
+
+			// Depending on the cloud's configuration, the instance is
+			// offloaded right away or stays shelved until offloaded.
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"ACTIVE", "SHUTOFF"},
+				Target:     []string{"SHELVED", "SHELVED_OFFLOADED"},
+				Refresh:    ServerV2StateRefreshFunc(client, instanceID),
+				Timeout:    timeout,
+				Delay:      10 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			s, err := stateConf.WaitForState()
+			if err != nil {
+				return fmt.Errorf("Error waiting for instance (%s) to shelve: %s", instanceID, err)
+			}
+			status = s.(*servers.Server).Status
+		}
+
+		if status == "SHELVED" {
+			if err := serverV2Action(client, instanceID, "shelveOffload"); err != nil {
+				return fmt.Errorf("Error offloading OpenStack server (%s): %s", instanceID, err)
+			}
+			return waitForServerV2Status(client, instanceID, []string{"SHELVED"}, "SHELVED_OFFLOADED", timeout)
+		}
+	}
+
+	return nil
+}
+
+func waitForServerV2Status(client *gophercloud.ServiceClient, instanceID string, pending []string, target string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for instance (%s) to become %s", instanceID, target)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    ServerV2StateRefreshFunc(client, instanceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to become %s: %s", instanceID, target, err)
+	}
+
+	return nil
+}
+
+// serverV2Action runs a server action without arguments, such as shelve,
+// which the vendored gophercloud does not support.
+func serverV2Action(client *gophercloud.ServiceClient, instanceID, action string) error {
+	reqBody := map[string]interface{}{action: nil}
+	resp, err := client.Post(client.ServiceURL("servers", instanceID, "action"), reqBody, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Body.Close()
+	return nil
+}
+
+func resourceInstanceRescueOptsV2(d *schema.ResourceData) (InstanceRescueOpts, bool) {
+	var opts InstanceRescueOpts
+
+	rescueRaw := d.Get("rescue").([]interface{})
+	if len(rescueRaw) == 0 {
+		return opts, false
+	}
+
+	if rescue, ok := rescueRaw[0].(map[string]interface{}); ok {
+		opts.RescueImageRef = rescue["image_id"].(string)
+		opts.AdminPass = rescue["admin_pass"].(string)
+	}
+
+	return opts, true
+}
+
+// rescueInstance boots an instance from a rescue image, which is its own
+// image unless another one is given, with its disk attached.
+func rescueInstance(client *gophercloud.ServiceClient, instanceID string, opts InstanceRescueOpts, timeout time.Duration) error {
+	log.Printf("[DEBUG] Rescuing instance (%s) with image %q", instanceID, opts.RescueImageRef)
+
+	reqBody := map[string]interface{}{"rescue": opts}
+	_, err := client.Post(client.ServiceURL("servers", instanceID, "action"), reqBody, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return fmt.Errorf("Error rescuing OpenStack server (%s): %s", instanceID, err)
+	}
+
+	return waitForServerV2Status(client, instanceID, []string{"ACTIVE", "SHUTOFF"}, "RESCUE", timeout)
+}
+
+func unrescueInstance(client *gophercloud.ServiceClient, instanceID string, timeout time.Duration) error {
+	server, err := servers.Get(client, instanceID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenStack server (%s): %s", instanceID, err)
+	}
+
+	if server.Status != "RESCUE" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Unrescuing instance (%s)", instanceID)
+	if err := serverV2Action(client, instanceID, "unrescue"); err != nil {
+		return fmt.Errorf("Error unrescuing OpenStack server (%s): %s", instanceID, err)
+	}
+
+	return waitForServerV2Status(client, instanceID, []string{"RESCUE"}, "ACTIVE", timeout)
+}
+
+// confirmInstanceResize waits for a resize to the given flavor to finish and
+// confirms it. Clouds with a resize_confirm_window confirm resizes on their
+// own, possibly before the instance is seen in VERIFY_RESIZE. The cloud is
+// given confirmDelay to do so before the resize is confirmed here, or all of
+// the timeout when autoConfirm is false.
+func confirmInstanceResize(client *gophercloud.ServiceClient, instanceID, flavorID string, autoConfirm bool, confirmDelay, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for instance (%s) to finish resizing", instanceID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"RESIZE"},
+		Target:     []string{"VERIFY_RESIZE", "ACTIVE", "SHUTOFF"},
+		Refresh:    ServerV2ResizeStateRefreshFunc(client, instanceID, flavorID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	s, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to resize: %s", instanceID, err)
+	}
+	if s.(*servers.Server).Status != "VERIFY_RESIZE" {
+		log.Printf("[DEBUG] Resize of instance (%s) was confirmed by the cloud", instanceID)
+		return nil
+	}
+
+	// A resized instance returns to the status it had before.
+	stateConf = &resource.StateChangeConf{
+		Pending:    []string{"VERIFY_RESIZE"},
+		Target:     []string{"ACTIVE", "SHUTOFF"},
+		Refresh:    ServerV2ResizeStateRefreshFunc(client, instanceID, flavorID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if !autoConfirm || confirmDelay > 0 {
+		waitConf := *stateConf
+		if autoConfirm {
+			waitConf.Timeout = confirmDelay
+		}
+
+		log.Printf("[DEBUG] Waiting for the cloud to confirm resize of instance (%s)", instanceID)
+		_, err = waitConf.WaitForState()
+		if err == nil {
+			return nil
+		}
+		if _, ok := err.(*resource.TimeoutError); !ok || !autoConfirm {
+			return fmt.Errorf("Error waiting for instance (%s) to confirm resize: %s", instanceID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Confirming resize of instance (%s)", instanceID)
+	err = servers.ConfirmResize(client, instanceID).ExtractErr()
+	if err != nil {
+		// The cloud may have confirmed the resize in the meantime.
+		if errCode, ok := err.(gophercloud.ErrUnexpectedResponseCode); !ok || errCode.Actual != 409 {
+			return fmt.Errorf("Error confirming resize of OpenStack server: %s", err)
+		}
+		log.Printf("[DEBUG] Resize of instance (%s) was already confirmed", instanceID)
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for instance (%s) to confirm resize: %s", instanceID, err)
+	}
+
+	return nil
+}
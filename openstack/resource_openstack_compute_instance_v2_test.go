@@ -14,6 +14,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
@@ -389,6 +390,79 @@ func TestAccComputeV2Instance_bootFromVolumeImage(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_deviceTags(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_deviceTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.0.tag", "net-tag"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "block_device.0.tag", "root-tag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_blockDeviceVolumeType(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVolumeType(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_blockDeviceVolumeType,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "block_device.0.volume_type", OS_VOLUME_TYPE),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_hostname(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_hostname_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "hostname", "host-1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_hostname_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "hostname", "host-2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_bootFromVolumeImageWithAttachedVolume(t *testing.T) {
 	var instance servers.Server
 
@@ -596,6 +670,25 @@ func TestAccComputeV2Instance_stopBeforeDestroy(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_gracefulShutdown(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_gracefulShutdown,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "vendor_options.0.graceful_shutdown_timeout", "120"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2Instance_metadataRemove(t *testing.T) {
 	var instance servers.Server
 
@@ -688,6 +781,304 @@ func TestAccComputeV2Instance_networkNameToID(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_waitForCloudInit(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_waitForCloudInit,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "wait_for_cloudinit", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_waitForPortsActive(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_waitForPortsActive,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "wait_for_ports_active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_configDrive(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_configDrive,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "config_drive", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_powerState(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_powerStateShutoff,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "SHUTOFF"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_powerStateShelved,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "SHELVED_OFFLOADED"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_powerStateActive,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_rescue(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_rescueNone,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_rescue,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "RESCUE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_rescueNone,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_rescueDestroy(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_rescueStopBeforeDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "RESCUE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_rebootOnChange(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_rebootOnChange_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_rebootOnChange_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "metadata.role", "db"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_locked(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_lockedTrue,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "locked", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_lockedFalse,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "locked", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_lockedTrue,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "locked", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_schedulerHintsAdditionalProperties(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_schedulerHintsAdditionalProperties,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_portName(t *testing.T) {
+	var instance servers.Server
+	var port ports.Port
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_portName,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttrPtr(
+						"openstack_compute_instance_v2.instance_1", "network.0.mac", &port.MACAddress),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_resize(t *testing.T) {
+	var instance servers.Server
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckResizeFlavor(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_resize,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceStatus(&instance, "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "flavor_id", OS_RESIZE_FLAVOR_ID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_managePortSecGroups(t *testing.T) {
+	var instance servers.Server
+	var port ports.Port
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_managePortSecGroups_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					testAccCheckComputeV2InstancePortSecGroupCount(&port, 1),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_managePortSecGroups_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					testAccCheckComputeV2InstancePortSecGroupCount(&port, 2),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "security_groups.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2InstanceDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	computeClient, err := config.computeV2Client(OS_REGION_NAME)
@@ -763,6 +1154,16 @@ func testAccCheckComputeV2InstanceDoesNotExist(n string, instance *servers.Serve
 	}
 }
 
+func testAccCheckComputeV2InstanceStatus(instance *servers.Server, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance.Status != status {
+			return fmt.Errorf("Instance has status %s instead of %s", instance.Status, status)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeV2InstanceMetadata(
 	instance *servers.Server, k string, v string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1313,24 +1714,91 @@ resource "openstack_compute_secgroup_v2" "secgroup_2" {
 
 resource "openstack_compute_instance_v2" "instance_1" {
   name = "instance_1"
-  security_groups = ["default", "${openstack_compute_secgroup_v2.secgroup_1.name}", "${openstack_compute_secgroup_v2.secgroup_2.name}"]
+  security_groups = ["default", "${openstack_compute_secgroup_v2.secgroup_1.name}", "${openstack_compute_secgroup_v2.secgroup_2.name}"]
+}
+`
+
+var testAccComputeV2Instance_bootFromVolumeImage = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  block_device {
+    uuid = "%s"
+    source_type = "image"
+    volume_size = 5
+    boot_index = 0
+    destination_type = "volume"
+    delete_on_termination = true
+  }
+}
+`, OS_IMAGE_ID)
+
+var testAccComputeV2Instance_deviceTags = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+
+  network {
+    uuid = "%s"
+    tag = "net-tag"
+  }
+
+  block_device {
+    uuid = "%s"
+    source_type = "image"
+    volume_size = 5
+    boot_index = 0
+    destination_type = "volume"
+    delete_on_termination = true
+    tag = "root-tag"
+  }
 }
-`
+`, OS_NETWORK_ID, OS_IMAGE_ID)
 
-var testAccComputeV2Instance_bootFromVolumeImage = fmt.Sprintf(`
+var testAccComputeV2Instance_blockDeviceVolumeType = fmt.Sprintf(`
 resource "openstack_compute_instance_v2" "instance_1" {
   name = "instance_1"
   security_groups = ["default"]
+
+  network {
+    uuid = "%s"
+  }
+
   block_device {
     uuid = "%s"
     source_type = "image"
     volume_size = 5
     boot_index = 0
     destination_type = "volume"
+    volume_type = "%s"
     delete_on_termination = true
   }
 }
-`, OS_IMAGE_ID)
+`, OS_NETWORK_ID, OS_IMAGE_ID, OS_VOLUME_TYPE)
+
+var testAccComputeV2Instance_hostname_1 = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  hostname = "host-1"
+  security_groups = ["default"]
+
+  network {
+    uuid = "%s"
+  }
+}
+`, OS_NETWORK_ID)
+
+var testAccComputeV2Instance_hostname_2 = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  hostname = "host-2"
+  security_groups = ["default"]
+
+  network {
+    uuid = "%s"
+  }
+}
+`, OS_NETWORK_ID)
 
 var testAccComputeV2Instance_bootFromVolumeImageWithAttachedVolume = fmt.Sprintf(`
 resource "openstack_blockstorage_volume_v1" "volume_1" {
@@ -1562,6 +2030,16 @@ resource "openstack_compute_instance_v2" "instance_1" {
 }
 `
 
+const testAccComputeV2Instance_gracefulShutdown = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  vendor_options {
+    graceful_shutdown_timeout = 120
+  }
+}
+`
+
 const testAccComputeV2Instance_metadataRemove_1 = `
 resource "openstack_compute_instance_v2" "instance_1" {
   name = "instance_1"
@@ -1633,3 +2111,279 @@ resource "openstack_compute_instance_v2" "instance_1" {
 
 }
 `, OS_NETWORK_ID)
+
+const testAccComputeV2Instance_waitForCloudInit = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  user_data = "#cloud-config\nruncmd:\n  - echo done > /tmp/done\n"
+  wait_for_cloudinit = true
+
+  timeouts {
+    create = "15m"
+  }
+}
+`
+
+const testAccComputeV2Instance_waitForPortsActive = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  wait_for_ports_active = true
+}
+`
+
+const testAccComputeV2Instance_configDrive = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  config_drive = true
+}
+`
+
+const testAccComputeV2Instance_powerStateShutoff = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  power_state = "shutoff"
+}
+`
+
+const testAccComputeV2Instance_powerStateShelved = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  power_state = "shelved_offloaded"
+}
+`
+
+const testAccComputeV2Instance_powerStateActive = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  power_state = "active"
+}
+`
+
+const testAccComputeV2Instance_rescueNone = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+`
+
+var testAccComputeV2Instance_rescue = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+
+  rescue {
+    image_id = "%s"
+  }
+}
+`, OS_IMAGE_ID)
+
+var testAccComputeV2Instance_rescueStopBeforeDestroy = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  stop_before_destroy = true
+
+  rescue {
+    image_id = "%s"
+  }
+}
+`, OS_IMAGE_ID)
+
+const testAccComputeV2Instance_rebootOnChange_1 = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  metadata {
+    role = "web"
+  }
+
+  reboot_on_change {
+    metadata_keys = ["role"]
+  }
+}
+`
+
+const testAccComputeV2Instance_rebootOnChange_2 = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  metadata {
+    role = "db"
+  }
+
+  reboot_on_change {
+    metadata_keys = ["role"]
+  }
+}
+`
+
+const testAccComputeV2Instance_lockedTrue = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  locked = true
+}
+`
+
+const testAccComputeV2Instance_lockedFalse = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  locked = false
+}
+`
+
+const testAccComputeV2Instance_schedulerHintsAdditionalProperties = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+
+  scheduler_hints {
+    additional_properties {
+      terraform_acceptance_test = "true"
+    }
+  }
+}
+`
+
+func testAccCheckComputeV2InstancePortSecGroupCount(port *ports.Port, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(port.SecurityGroups) != count {
+			return fmt.Errorf("Expected port %s to have %d security groups, got %v",
+				port.ID, count, port.SecurityGroups)
+		}
+
+		return nil
+	}
+}
+
+const testAccComputeV2Instance_portName = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.1.0/24"
+  ip_version = 4
+  enable_dhcp = true
+  no_gateway = true
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  admin_state_up = "true"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+
+  network {
+    uuid = "${openstack_networking_network_v2.network_1.id}"
+    port_name = "${openstack_networking_port_v2.port_1.name}"
+  }
+}
+`
+
+var testAccComputeV2Instance_resize = fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  flavor_id = "%s"
+  auto_confirm_resize_delay = 30
+  metadata {
+    foo = "bar"
+  }
+}
+`, OS_RESIZE_FLAVOR_ID)
+
+const testAccComputeV2Instance_managePortSecGroups_1 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.1.0/24"
+  ip_version = 4
+  enable_dhcp = true
+  no_gateway = true
+}
+
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  admin_state_up = "true"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  manage_port_security_groups = true
+
+  network {
+    port = "${openstack_networking_port_v2.port_1.id}"
+  }
+}
+`
+
+const testAccComputeV2Instance_managePortSecGroups_2 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.1.0/24"
+  ip_version = 4
+  enable_dhcp = true
+  no_gateway = true
+}
+
+resource "openstack_networking_secgroup_v2" "secgroup_1" {
+  name = "secgroup_1"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  admin_state_up = "true"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default", "${openstack_networking_secgroup_v2.secgroup_1.name}"]
+  manage_port_security_groups = true
+
+  network {
+    port = "${openstack_networking_port_v2.port_1.id}"
+  }
+}
+`
@@ -0,0 +1,282 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// computeInterfaceAttachV2TagMicroversion is the Compute API version used to
+// attach an interface with a device tag.
+const computeInterfaceAttachV2TagMicroversion = "2.49"
+
+func resourceComputeInterfaceAttachV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInterfaceAttachV2Create,
+		Read:   resourceComputeInterfaceAttachV2Read,
+		Delete: resourceComputeInterfaceAttachV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"fixed_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tag": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"mac": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInterfaceAttachV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	portId := d.Get("port_id").(string)
+	networkId := d.Get("network_id").(string)
+	fixedIP := d.Get("fixed_ip").(string)
+
+	if (portId == "") == (networkId == "") {
+		return fmt.Errorf("Exactly one of port_id or network_id must be specified")
+	}
+	if portId != "" && fixedIP != "" {
+		return fmt.Errorf("fixed_ip can only be specified with network_id")
+	}
+
+	attachOpts := ComputeInterfaceAttachmentCreateOpts{
+		PortID: portId,
+		NetID:  networkId,
+		Tag:    d.Get("tag").(string),
+	}
+	if fixedIP != "" {
+		attachOpts.FixedIPs = []ComputeInterfaceAttachmentFixedIP{
+			{IPAddress: fixedIP},
+		}
+	}
+
+	log.Printf("[DEBUG] Creating interface attachment: %#v", attachOpts)
+
+	// Only use the microversion which supports tags when one is set, so
+	// attaching interfaces keeps working on older clouds.
+	attachClient := computeClient
+	if attachOpts.Tag != "" {
+		client := *computeClient
+		client.Microversion = computeInterfaceAttachV2TagMicroversion
+		attachClient = &client
+	}
+
+	attachment, err := computeInterfaceAttachV2Create(attachClient, instanceId, attachOpts)
+	if err != nil {
+		return fmt.Errorf("Error attaching OpenStack interface: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ATTACHING"},
+		Target:     []string{"ATTACHED"},
+		Refresh:    resourceComputeInterfaceAttachV2AttachFunc(computeClient, instanceId, attachment.PortID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error attaching OpenStack interface: %s", err)
+	}
+
+	log.Printf("[DEBUG] Created interface attachment: %#v", attachment)
+
+	// Use the instance ID and port ID as the resource ID.
+	// This is because an attachment is identified by the port it uses.
+	id := fmt.Sprintf("%s/%s", instanceId, attachment.PortID)
+
+	d.SetId(id)
+
+	return resourceComputeInterfaceAttachV2Read(d, meta)
+}
+
+func resourceComputeInterfaceAttachV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceId, portId, err := parseComputeInterfaceAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	attachment, err := computeInterfaceAttachV2Get(computeClient, instanceId, portId)
+	if err != nil {
+		return CheckDeleted(d, err, "compute_interface_attach")
+	}
+
+	log.Printf("[DEBUG] Retrieved interface attachment: %#v", attachment)
+
+	// Keep the fixed IP which was asked for if the port has several.
+	fixedIP := d.Get("fixed_ip").(string)
+	found := false
+	for _, ip := range attachment.FixedIPs {
+		if ip.IPAddress == fixedIP {
+			found = true
+		}
+	}
+	if !found && len(attachment.FixedIPs) > 0 {
+		fixedIP = attachment.FixedIPs[0].IPAddress
+	}
+
+	d.Set("instance_id", instanceId)
+	d.Set("port_id", attachment.PortID)
+	d.Set("network_id", attachment.NetID)
+	d.Set("fixed_ip", fixedIP)
+	d.Set("mac", attachment.MACAddr)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceComputeInterfaceAttachV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceId, portId, err := parseComputeInterfaceAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{""},
+		Target:     []string{"DETACHED"},
+		Refresh:    resourceComputeInterfaceAttachV2DetachFunc(computeClient, instanceId, portId),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error detaching OpenStack interface: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeInterfaceAttachV2AttachFunc(
+	computeClient *gophercloud.ServiceClient, instanceId, portId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachment, err := computeInterfaceAttachV2Get(computeClient, instanceId, portId)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return attachment, "ATTACHING", nil
+			}
+			return attachment, "", err
+		}
+
+		if attachment.PortState != "ACTIVE" {
+			return attachment, "ATTACHING", nil
+		}
+
+		return attachment, "ATTACHED", nil
+	}
+}
+
+func resourceComputeInterfaceAttachV2DetachFunc(
+	computeClient *gophercloud.ServiceClient, instanceId, portId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Attempting to detach OpenStack interface %s from instance %s",
+			portId, instanceId)
+
+		attachment, err := computeInterfaceAttachV2Get(computeClient, instanceId, portId)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return attachment, "DETACHED", nil
+			}
+			return attachment, "", err
+		}
+
+		err = computeInterfaceAttachV2Delete(computeClient, instanceId, portId)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return attachment, "DETACHED", nil
+			}
+
+			if _, ok := err.(gophercloud.ErrDefault400); ok {
+				return nil, "", nil
+			}
+
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack interface attachment (%s) is still active.", portId)
+		return nil, "", nil
+	}
+}
+
+func parseComputeInterfaceAttachmentId(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("Unable to determine interface attachment ID")
+	}
+
+	instanceId := idParts[0]
+	portId := idParts[1]
+
+	return instanceId, portId, nil
+}
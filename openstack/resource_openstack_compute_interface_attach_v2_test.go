@@ -0,0 +1,232 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeV2InterfaceAttach_basic(t *testing.T) {
+	var ai ComputeInterfaceAttachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InterfaceAttachDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2InterfaceAttach_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InterfaceAttachExists("openstack_compute_interface_attach_v2.ai_1", &ai),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2InterfaceAttach_IP(t *testing.T) {
+	var ai ComputeInterfaceAttachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InterfaceAttachDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2InterfaceAttach_IP,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InterfaceAttachExists("openstack_compute_interface_attach_v2.ai_1", &ai),
+					testAccCheckComputeV2InterfaceAttachIP(&ai, "192.168.1.100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2InterfaceAttach_tag(t *testing.T) {
+	var ai ComputeInterfaceAttachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InterfaceAttachDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2InterfaceAttach_tag,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InterfaceAttachExists("openstack_compute_interface_attach_v2.ai_1", &ai),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_interface_attach_v2.ai_1", "tag", "appliance-uplink"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2InterfaceAttachDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	computeClient, err := config.computeV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_compute_interface_attach_v2" {
+			continue
+		}
+
+		instanceId, portId, err := parseComputeInterfaceAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = computeInterfaceAttachV2Get(computeClient, instanceId, portId)
+		if err == nil {
+			return fmt.Errorf("Interface attachment still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeV2InterfaceAttachExists(n string, ai *ComputeInterfaceAttachment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		instanceId, portId, err := parseComputeInterfaceAttachmentId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := computeInterfaceAttachV2Get(computeClient, instanceId, portId)
+		if err != nil {
+			return err
+		}
+
+		if found.PortID != portId {
+			return fmt.Errorf("InterfaceAttach not found")
+		}
+
+		*ai = *found
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2InterfaceAttachIP(ai *ComputeInterfaceAttachment, ip string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, i := range ai.FixedIPs {
+			if i.IPAddress == ip {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Requested ip (%s) does not exist on port", ip)
+	}
+}
+
+const testAccComputeV2InterfaceAttach_basic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.1.0/24"
+  ip_version = 4
+  enable_dhcp = true
+  no_gateway = true
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  admin_state_up = "true"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+resource "openstack_compute_interface_attach_v2" "ai_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  port_id = "${openstack_networking_port_v2.port_1.id}"
+}
+`
+
+const testAccComputeV2InterfaceAttach_IP = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.1.0/24"
+  ip_version = 4
+  enable_dhcp = true
+  no_gateway = true
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+resource "openstack_compute_interface_attach_v2" "ai_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  fixed_ip = "192.168.1.100"
+
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+}
+`
+
+const testAccComputeV2InterfaceAttach_tag = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.1.0/24"
+  ip_version = 4
+  enable_dhcp = true
+  no_gateway = true
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+resource "openstack_compute_interface_attach_v2" "ai_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  tag = "appliance-uplink"
+
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+}
+`
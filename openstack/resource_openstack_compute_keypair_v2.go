@@ -8,6 +8,10 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// computeKeypairV2TypeMicroversion is the Compute API version which supports
+// the type of keypairs.
+const computeKeypairV2TypeMicroversion = "2.2"
+
 func resourceComputeKeypairV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeKeypairV2Create,
@@ -33,6 +37,29 @@ func resourceComputeKeypairV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
+				Computed: true,
+			},
+			"private_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"fingerprint": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "ssh" && value != "x509" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'ssh' and 'x509' are supported values for 'type'"))
+					}
+					return
+				},
 			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
@@ -55,9 +82,16 @@ func resourceComputeKeypairV2Create(d *schema.ResourceData, meta interface{}) er
 			Name:      d.Get("name").(string),
 			PublicKey: d.Get("public_key").(string),
 		},
+		d.Get("type").(string),
 		MapValueSpecs(d),
 	}
 
+	if createOpts.Type != "" {
+		client := *computeClient
+		client.Microversion = computeKeypairV2TypeMicroversion
+		computeClient = &client
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	kp, err := keypairs.Create(computeClient, createOpts).Extract()
 	if err != nil {
@@ -66,6 +100,12 @@ func resourceComputeKeypairV2Create(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(kp.Name)
 
+	// Nova only returns the private key when it generated the keypair, and
+	// only in the response to its creation.
+	if kp.PrivateKey != "" {
+		d.Set("private_key", kp.PrivateKey)
+	}
+
 	return resourceComputeKeypairV2Read(d, meta)
 }
 
@@ -76,13 +116,36 @@ func resourceComputeKeypairV2Read(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	kp, err := keypairs.Get(computeClient, d.Id()).Extract()
+	// The type of a keypair is only returned from microversion 2.2, which
+	// older clouds don't support, so it is only read when it was set.
+	keyType := d.Get("type").(string)
+	if keyType != "" {
+		client := *computeClient
+		client.Microversion = computeKeypairV2TypeMicroversion
+		computeClient = &client
+	}
+
+	result := keypairs.Get(computeClient, d.Id())
+	kp, err := result.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "keypair")
 	}
 
+	if keyType != "" {
+		var kpWithType struct {
+			KeyPair struct {
+				Type string `json:"type"`
+			} `json:"keypair"`
+		}
+		if err := result.ExtractInto(&kpWithType); err != nil {
+			return fmt.Errorf("Error retrieving type of OpenStack keypair %s: %s", d.Id(), err)
+		}
+		d.Set("type", kpWithType.KeyPair.Type)
+	}
+
 	d.Set("name", kp.Name)
 	d.Set("public_key", kp.PublicKey)
+	d.Set("fingerprint", kp.Fingerprint)
 	d.Set("region", GetRegion(d))
 
 	return nil
@@ -28,6 +28,52 @@ func TestAccComputeV2Keypair_basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Keypair_generatePrivate(t *testing.T) {
+	var keypair keypairs.KeyPair
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2KeypairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Keypair_generatePrivate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2KeypairExists("openstack_compute_keypair_v2.kp_1", &keypair),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_keypair_v2.kp_1", "public_key"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_keypair_v2.kp_1", "private_key"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_keypair_v2.kp_1", "fingerprint"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Keypair_x509(t *testing.T) {
+	var keypair keypairs.KeyPair
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2KeypairDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Keypair_x509,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2KeypairExists("openstack_compute_keypair_v2.kp_1", &keypair),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_keypair_v2.kp_1", "type", "x509"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_compute_keypair_v2.kp_1", "private_key"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2KeypairDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	computeClient, err := config.computeV2Client(OS_REGION_NAME)
@@ -87,3 +133,16 @@ resource "openstack_compute_keypair_v2" "kp_1" {
   public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDAjpC1hwiOCCmKEWxJ4qzTTsJbKzndLo1BCz5PcwtUnflmU+gHJtWMZKpuEGVi29h0A/+ydKek1O18k10Ff+4tyFjiHDQAT9+OfgWf7+b1yK+qDip3X1C0UPMbwHlTfSGWLGZquwhvEFx9k3h/M+VtMvwR1lJ9LUyTAImnNjWG7TAIPmui30HvM2UiFEmqkr4ijq45MyX2+fLIePLRIFuu1p4whjHAQYufqyno3BS48icQb4p6iVEZPo4AE2o9oIyQvj2mx4dk5Y8CgSETOZTYDOR3rU2fZTRDRgPJDH9FWvQjF5tA0p3d9CoWWd2s6GKKbfoUIi8R/Db1BSPJwkqB jrp-hp-pc"
 }
 `
+
+const testAccComputeV2Keypair_generatePrivate = `
+resource "openstack_compute_keypair_v2" "kp_1" {
+  name = "kp_1"
+}
+`
+
+const testAccComputeV2Keypair_x509 = `
+resource "openstack_compute_keypair_v2" "kp_1" {
+  name = "kp_1"
+  type = "x509"
+}
+`
@@ -8,6 +8,18 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+const (
+	// computeServerGroupV2SoftPolicyMicroversion is the first Compute API
+	// version which supports the soft-affinity and soft-anti-affinity
+	// policies.
+	computeServerGroupV2SoftPolicyMicroversion = "2.15"
+
+	// computeServerGroupV2RulesMicroversion is the first Compute API version
+	// which supports server group rules. It replaces the policies of a
+	// server group by a single policy.
+	computeServerGroupV2RulesMicroversion = "2.64"
+)
+
 func resourceComputeServerGroupV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeServerGroupV2Create,
@@ -36,6 +48,21 @@ func resourceComputeServerGroupV2() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"rules": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_server_per_host": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"members": &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
@@ -57,12 +84,20 @@ func resourceComputeServerGroupV2Create(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
+	policies := resourceServerGroupPoliciesV2(d)
+	rules := resourceServerGroupRulesV2(d)
+	if len(rules) > 0 && (len(policies) != 1 || policies[0] != "anti-affinity") {
+		return fmt.Errorf("rules can only be used with the single policy \"anti-affinity\"")
+	}
+	computeClient.Microversion = resourceServerGroupMicroversionV2(policies, rules)
+
 	createOpts := ServerGroupCreateOpts{
 		servergroups.CreateOpts{
 			Name:     d.Get("name").(string),
-			Policies: resourceServerGroupPoliciesV2(d),
+			Policies: policies,
 		},
 		MapValueSpecs(d),
+		rules,
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -83,11 +118,27 @@ func resourceComputeServerGroupV2Read(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	sg, err := servergroups.Get(computeClient, d.Id()).Extract()
+	// The rules of a server group are only returned with the microversion
+	// which introduced them.
+	if len(resourceServerGroupRulesV2(d)) > 0 {
+		computeClient.Microversion = computeServerGroupV2RulesMicroversion
+	}
+
+	var res struct {
+		ServerGroup struct {
+			servergroups.ServerGroup
+			Policy string `json:"policy"`
+			Rules  struct {
+				MaxServerPerHost int `json:"max_server_per_host"`
+			} `json:"rules"`
+		} `json:"server_group"`
+	}
+	err = servergroups.Get(computeClient, d.Id()).ExtractInto(&res)
 	if err != nil {
 		return CheckDeleted(d, err, "server group")
 	}
 
+	sg := res.ServerGroup
 	log.Printf("[DEBUG] Retrieved ServerGroup %s: %+v", d.Id(), sg)
 
 	// Set the name
@@ -98,8 +149,20 @@ func resourceComputeServerGroupV2Read(d *schema.ResourceData, meta interface{})
 	for _, p := range sg.Policies {
 		policies = append(policies, p)
 	}
+	if sg.Policy != "" {
+		policies = append(policies, sg.Policy)
+	}
 	d.Set("policies", policies)
 
+	// Set the rules
+	rules := []map[string]interface{}{}
+	if sg.Rules.MaxServerPerHost > 0 {
+		rules = append(rules, map[string]interface{}{
+			"max_server_per_host": sg.Rules.MaxServerPerHost,
+		})
+	}
+	d.Set("rules", rules)
+
 	// Set the members
 	members := []string{}
 	for _, m := range sg.Members {
@@ -135,3 +198,31 @@ func resourceServerGroupPoliciesV2(d *schema.ResourceData) []string {
 	}
 	return policies
 }
+
+func resourceServerGroupRulesV2(d *schema.ResourceData) map[string]interface{} {
+	rawRules := d.Get("rules").([]interface{})
+	if len(rawRules) == 0 || rawRules[0] == nil {
+		return nil
+	}
+
+	rules := rawRules[0].(map[string]interface{})
+	return map[string]interface{}{
+		"max_server_per_host": rules["max_server_per_host"].(int),
+	}
+}
+
+// resourceServerGroupMicroversionV2 returns the lowest Compute API version
+// which supports the given policies and rules.
+func resourceServerGroupMicroversionV2(policies []string, rules map[string]interface{}) string {
+	if len(rules) > 0 {
+		return computeServerGroupV2RulesMicroversion
+	}
+
+	for _, p := range policies {
+		if p == "soft-affinity" || p == "soft-anti-affinity" {
+			return computeServerGroupV2SoftPolicyMicroversion
+		}
+	}
+
+	return ""
+}
@@ -50,6 +50,48 @@ func TestAccComputeV2ServerGroup_affinity(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2ServerGroup_soft(t *testing.T) {
+	var sg servergroups.ServerGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2ServerGroup_soft,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists("openstack_compute_servergroup_v2.sg_1", &sg),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_servergroup_v2.sg_1", "policies.0", "soft-anti-affinity"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2ServerGroup_rules(t *testing.T) {
+	var sg servergroups.ServerGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2ServerGroup_rules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists("openstack_compute_servergroup_v2.sg_1", &sg),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_servergroup_v2.sg_1", "policies.0", "anti-affinity"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_servergroup_v2.sg_1", "rules.0.max_server_per_host", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2ServerGroupDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	computeClient, err := config.computeV2Client(OS_REGION_NAME)
@@ -124,6 +166,24 @@ resource "openstack_compute_servergroup_v2" "sg_1" {
 }
 `
 
+const testAccComputeV2ServerGroup_soft = `
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["soft-anti-affinity"]
+}
+`
+
+const testAccComputeV2ServerGroup_rules = `
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["anti-affinity"]
+
+  rules {
+    max_server_per_host = 2
+  }
+}
+`
+
 const testAccComputeV2ServerGroup_affinity = `
 resource "openstack_compute_servergroup_v2" "sg_1" {
   name = "sg_1"
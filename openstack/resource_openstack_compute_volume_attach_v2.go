@@ -13,6 +13,16 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+const (
+	// computeVolumeAttachV2TagMicroversion is the Compute API version used
+	// to attach a volume with a device tag.
+	computeVolumeAttachV2TagMicroversion = "2.49"
+
+	// computeVolumeAttachV2MultiattachMicroversion is the Compute API
+	// version used to attach a multiattach volume. It also supports tags.
+	computeVolumeAttachV2MultiattachMicroversion = "2.60"
+)
+
 func resourceComputeVolumeAttachV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeVolumeAttachV2Create,
@@ -52,6 +62,19 @@ func resourceComputeVolumeAttachV2() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+
+			"tag": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"multiattach": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
 		},
 	}
 }
@@ -71,14 +94,33 @@ func resourceComputeVolumeAttachV2Create(d *schema.ResourceData, meta interface{
 		device = v.(string)
 	}
 
-	attachOpts := volumeattach.CreateOpts{
-		Device:   device,
-		VolumeID: volumeId,
+	attachOpts := VolumeAttachCreateOpts{
+		volumeattach.CreateOpts{
+			Device:   device,
+			VolumeID: volumeId,
+		},
+		d.Get("tag").(string),
+	}
+
+	// Only use a microversion when a tag or a multiattach volume needs it,
+	// so attaching volumes keeps working on older clouds.
+	attachClient := computeClient
+	microversion := ""
+	if attachOpts.Tag != "" {
+		microversion = computeVolumeAttachV2TagMicroversion
+	}
+	if d.Get("multiattach").(bool) {
+		microversion = computeVolumeAttachV2MultiattachMicroversion
+	}
+	if microversion != "" {
+		client := *computeClient
+		client.Microversion = microversion
+		attachClient = &client
 	}
 
 	log.Printf("[DEBUG] Creating volume attachment: %#v", attachOpts)
 
-	attachment, err := volumeattach.Create(computeClient, instanceId, attachOpts).Extract()
+	attachment, err := volumeattach.Create(attachClient, instanceId, attachOpts).Extract()
 	if err != nil {
 		return err
 	}
@@ -47,6 +47,47 @@ func TestAccComputeV2VolumeAttach_device(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2VolumeAttach_tag(t *testing.T) {
+	var va volumeattach.VolumeAttachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2VolumeAttachDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2VolumeAttach_tag,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2VolumeAttachExists("openstack_compute_volume_attach_v2.va_1", &va),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_volume_attach_v2.va_1", "tag", "data-tag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2VolumeAttach_multiattach(t *testing.T) {
+	var va1, va2 volumeattach.VolumeAttachment
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2VolumeAttachDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2VolumeAttach_multiattach,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2VolumeAttachExists("openstack_compute_volume_attach_v2.va_1", &va1),
+					testAccCheckComputeV2VolumeAttachExists("openstack_compute_volume_attach_v2.va_2", &va2),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v2.volume_1", "multiattach", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeV2VolumeAttach_timeout(t *testing.T) {
 	var va volumeattach.VolumeAttachment
 
@@ -175,6 +216,56 @@ resource "openstack_compute_volume_attach_v2" "va_1" {
 }
 `
 
+const testAccComputeV2VolumeAttach_tag = `
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+resource "openstack_compute_volume_attach_v2" "va_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  volume_id = "${openstack_blockstorage_volume_v2.volume_1.id}"
+  tag = "data-tag"
+}
+`
+
+const testAccComputeV2VolumeAttach_multiattach = `
+resource "openstack_blockstorage_volume_v2" "volume_1" {
+  name = "volume_1"
+  size = 1
+  multiattach = true
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+resource "openstack_compute_instance_v2" "instance_2" {
+  name = "instance_2"
+  security_groups = ["default"]
+}
+
+resource "openstack_compute_volume_attach_v2" "va_1" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+  volume_id = "${openstack_blockstorage_volume_v2.volume_1.id}"
+  multiattach = true
+}
+
+resource "openstack_compute_volume_attach_v2" "va_2" {
+  instance_id = "${openstack_compute_instance_v2.instance_2.id}"
+  volume_id = "${openstack_blockstorage_volume_v2.volume_1.id}"
+  multiattach = true
+
+  depends_on = ["openstack_compute_volume_attach_v2.va_1"]
+}
+`
+
 const testAccComputeV2VolumeAttach_timeout = `
 resource "openstack_blockstorage_volume_v2" "volume_1" {
   name = "volume_1"
@@ -0,0 +1,122 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceContainerInfraQuotaV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerInfraQuotaV1Create,
+		Read:   resourceContainerInfraQuotaV1Read,
+		Update: resourceContainerInfraQuotaV1Update,
+		Delete: resourceContainerInfraQuotaV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cluster": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraQuotaV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	createOpts := ContainerInfraQuota{
+		ProjectID: d.Get("project_id").(string),
+		Resource:  containerInfraQuotaV1Resource,
+		HardLimit: d.Get("cluster").(int),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	if err := containerInfraQuotaV1Create(containerInfraClient, createOpts); err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra quota of project %s: %s", createOpts.ProjectID, err)
+	}
+
+	d.SetId(createOpts.ProjectID)
+
+	return resourceContainerInfraQuotaV1Read(d, meta)
+}
+
+func resourceContainerInfraQuotaV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	quota, err := containerInfraQuotaV1Get(containerInfraClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "container infra quota")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack container infra quota %s: %+v", d.Id(), quota)
+
+	d.Set("project_id", d.Id())
+	d.Set("cluster", quota.HardLimit)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceContainerInfraQuotaV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	if d.HasChange("cluster") {
+		updateOpts := ContainerInfraQuota{
+			ProjectID: d.Id(),
+			Resource:  containerInfraQuotaV1Resource,
+			HardLimit: d.Get("cluster").(int),
+		}
+
+		log.Printf("[DEBUG] Updating OpenStack container infra quota %s with options: %+v", d.Id(), updateOpts)
+		if err := containerInfraQuotaV1Update(containerInfraClient, updateOpts); err != nil {
+			return fmt.Errorf("Error updating OpenStack container infra quota: %s", err)
+		}
+	}
+
+	return resourceContainerInfraQuotaV1Read(d, meta)
+}
+
+func resourceContainerInfraQuotaV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	if err := containerInfraQuotaV1Delete(containerInfraClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "container infra quota")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,81 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContainerInfraV1Quota_basic(t *testing.T) {
+	var quota ContainerInfraQuota
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerInfraV1QuotaConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraV1QuotaExists("openstack_containerinfra_quota_v1.quota_1", &quota),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_quota_v1.quota_1", "cluster", "10"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContainerInfraV1QuotaConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_quota_v1.quota_1", "cluster", "15"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerInfraV1QuotaExists(n string, quota *ContainerInfraQuota) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		containerInfraClient, err := config.containerInfraV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+		}
+
+		found, err := containerInfraQuotaV1Get(containerInfraClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*quota = *found
+
+		return nil
+	}
+}
+
+var testAccContainerInfraV1QuotaConfig_basic = fmt.Sprintf(`
+resource "openstack_containerinfra_quota_v1" "quota_1" {
+  project_id = "%s"
+  cluster    = 10
+}
+`, os.Getenv("OS_TENANT_ID"))
+
+var testAccContainerInfraV1QuotaConfig_update = fmt.Sprintf(`
+resource "openstack_containerinfra_quota_v1" "quota_1" {
+  project_id = "%s"
+  cluster    = 15
+}
+`, os.Getenv("OS_TENANT_ID"))
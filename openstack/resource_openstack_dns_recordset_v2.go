@@ -8,6 +8,7 @@ import (
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,7 +21,7 @@ func resourceDNSRecordSetV2() *schema.Resource {
 		Update: resourceDNSRecordSetV2Update,
 		Delete: resourceDNSRecordSetV2Delete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceDNSRecordSetV2Import,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -74,6 +75,11 @@ func resourceDNSRecordSetV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"ignore_records_managed_externally": resourceDNSRecordSetV2ExternalSchema(),
+			"managed_externally": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -104,6 +110,13 @@ func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) erro
 
 	zoneID := d.Get("zone_id").(string)
 
+	if externalOpts, ok := resourceDNSRecordSetV2ExternalOpts(d); ok {
+		err := dnsRecordSetV2CheckExternalCreate(dnsClient, zoneID, createOpts.Name, createOpts.Type, externalOpts)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	n, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract()
 	if err != nil {
@@ -157,6 +170,18 @@ func resourceDNSRecordSetV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("region", GetRegion(d))
 	d.Set("zone_id", zoneID)
 
+	var external bool
+	if externalOpts, ok := resourceDNSRecordSetV2ExternalOpts(d); ok {
+		external, err = dnsRecordSetV2ManagedExternally(dnsClient, zoneID, n.Name, n.Type, n.Description, externalOpts)
+		if err != nil {
+			return err
+		}
+		if external {
+			log.Printf("[WARN] DNS record set %s is now managed outside of Terraform", d.Id())
+		}
+	}
+	d.Set("managed_externally", external)
+
 	return nil
 }
 
@@ -191,6 +216,11 @@ func resourceDNSRecordSetV2Update(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if d.Get("managed_externally").(bool) {
+		return fmt.Errorf("DNS record set %s is managed outside of Terraform, so it won't be updated. "+
+			"Remove it from the configuration to let the other tool manage it.", d.Id())
+	}
+
 	log.Printf("[DEBUG] Updating  record set %s with options: %#v", recordsetID, updateOpts)
 
 	_, err = recordsets.Update(dnsClient, zoneID, recordsetID, updateOpts).Extract()
@@ -226,6 +256,13 @@ func resourceDNSRecordSetV2Delete(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	// A record set taken over by another tool is left to it.
+	if d.Get("managed_externally").(bool) {
+		log.Printf("[WARN] DNS record set %s is managed outside of Terraform, removing it from the state only", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	err = recordsets.Delete(dnsClient, zoneID, recordsetID).ExtractErr()
 	if err != nil {
 		return fmt.Errorf("Error deleting OpenStack DNS  record set: %s", err)
@@ -274,3 +311,68 @@ func parseDNSV2RecordSetID(id string) (string, string, error) {
 
 	return zoneID, recordsetID, nil
 }
+
+// resourceDNSRecordSetV2Import accepts either zone_id/recordset_id or
+// zone_name/recordset_name/type. Names are resolved to the IDs the resource
+// is stored with, so record sets can be imported without looking them up
+// first. A record set name without a trailing dot is relative to the zone.
+func resourceDNSRecordSetV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 3 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	zoneName := idParts[0]
+	if !strings.HasSuffix(zoneName, ".") {
+		zoneName += "."
+	}
+
+	recordsetName := idParts[1]
+	if !strings.HasSuffix(recordsetName, ".") {
+		recordsetName += "." + zoneName
+	}
+
+	allPages, err := zones.List(dnsClient, zones.ListOpts{Name: zoneName}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list DNS zones named %s: %s", zoneName, err)
+	}
+
+	allZones, err := zones.ExtractZones(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve DNS zones named %s: %s", zoneName, err)
+	}
+
+	if len(allZones) != 1 {
+		return nil, fmt.Errorf("Expected one DNS zone named %s, found %d", zoneName, len(allZones))
+	}
+
+	listOpts := recordsets.ListOpts{
+		Name: recordsetName,
+		Type: strings.ToUpper(idParts[2]),
+	}
+
+	allPages, err = recordsets.ListByZone(dnsClient, allZones[0].ID, listOpts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list DNS record sets of zone %s: %s", zoneName, err)
+	}
+
+	allRecordSets, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to retrieve DNS record sets of zone %s: %s", zoneName, err)
+	}
+
+	if len(allRecordSets) != 1 {
+		return nil, fmt.Errorf("Expected one %s record set named %s in DNS zone %s, found %d",
+			listOpts.Type, recordsetName, zoneName, len(allRecordSets))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", allZones[0].ID, allRecordSets[0].ID))
+
+	return []*schema.ResourceData{d}, nil
+}
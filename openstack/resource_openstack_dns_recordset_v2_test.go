@@ -92,6 +92,31 @@ func TestAccDNSV2RecordSet_timeout(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2RecordSet_managedExternally(t *testing.T) {
+	var recordset recordsets.RecordSet
+	zoneName := randomZoneName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNSRecordSetV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2RecordSet_managedExternally(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists("openstack_dns_recordset_v2.recordset_1", &recordset),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_recordset_v2.recordset_1", "managed_externally", "false"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccDNSV2RecordSet_managedExternallyCollision(zoneName),
+				ExpectError: regexp.MustCompile("is managed outside of Terraform"),
+			},
+		},
+	})
+}
+
 func testAccCheckDNSV2RecordSetDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
@@ -247,3 +272,73 @@ func testAccDNSV2RecordSet_timeout(zoneName string) string {
 		}
 	`, zoneName, zoneName)
 }
+
+func testAccDNSV2RecordSet_managedExternally(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email2@example.com"
+			description = "a zone"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "openstack_dns_recordset_v2" "recordset_1" {
+			zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+			name = "www.%s"
+			type = "A"
+			ttl = 3000
+			records = ["10.1.0.4"]
+
+			ignore_records_managed_externally {
+				txt_prefix = "owner-"
+			}
+		}
+	`, zoneName, zoneName)
+}
+
+func testAccDNSV2RecordSet_managedExternallyCollision(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email2@example.com"
+			description = "a zone"
+			ttl = 6000
+			type = "PRIMARY"
+		}
+
+		resource "openstack_dns_recordset_v2" "recordset_1" {
+			zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+			name = "www.%s"
+			type = "A"
+			ttl = 3000
+			records = ["10.1.0.4"]
+
+			ignore_records_managed_externally {
+				txt_prefix = "owner-"
+			}
+		}
+
+		resource "openstack_dns_recordset_v2" "owner_1" {
+			zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+			name = "owner-app.%s"
+			type = "TXT"
+			ttl = 3000
+			records = ["\"heritage=external-dns,external-dns/owner=default\""]
+		}
+
+		resource "openstack_dns_recordset_v2" "recordset_2" {
+			depends_on = ["openstack_dns_recordset_v2.owner_1"]
+
+			zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+			name = "app.%s"
+			type = "A"
+			ttl = 3000
+			records = ["10.1.0.5"]
+
+			ignore_records_managed_externally {
+				txt_prefix = "owner-"
+			}
+		}
+	`, zoneName, zoneName, zoneName, zoneName)
+}
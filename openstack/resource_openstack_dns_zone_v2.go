@@ -5,10 +5,8 @@ import (
 	"log"
 	"time"
 
-	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
 
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -74,11 +72,26 @@ func resourceDNSZoneV2() *schema.Resource {
 				ForceNew: false,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"nameservers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
 				ForceNew: true,
 			},
+			"disable_status_check": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_delete": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -121,20 +134,15 @@ func resourceDNSZoneV2Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack DNS zone: %s", err)
 	}
 
-	log.Printf("[DEBUG] Waiting for DNS Zone (%s) to become available", n.ID)
-	stateConf := &resource.StateChangeConf{
-		Target:     []string{"ACTIVE"},
-		Pending:    []string{"PENDING"},
-		Refresh:    waitForDNSZone(dnsClient, n.ID),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-
 	d.SetId(n.ID)
 
+	if !d.Get("disable_status_check").(bool) {
+		err = dnsZoneV2WaitForStatus(dnsClient, n.ID, []string{"ACTIVE"}, []string{"PENDING"}, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack DNS Zone %s to become active: %s", n.ID, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Created OpenStack DNS Zone %s: %#v", n.ID, n)
 	return resourceDNSZoneV2Read(d, meta)
 }
@@ -162,6 +170,12 @@ func resourceDNSZoneV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("masters", n.Masters)
 	d.Set("region", GetRegion(d))
 
+	nameservers, err := dnsZoneV2Nameservers(dnsClient, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving nameservers of OpenStack DNS zone %s: %s", d.Id(), err)
+	}
+	d.Set("nameservers", nameservers)
+
 	return nil
 }
 
@@ -198,18 +212,13 @@ func resourceDNSZoneV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error updating OpenStack DNS Zone: %s", err)
 	}
 
-	log.Printf("[DEBUG] Waiting for DNS Zone (%s) to update", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Target:     []string{"ACTIVE"},
-		Pending:    []string{"PENDING"},
-		Refresh:    waitForDNSZone(dnsClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+	if !d.Get("disable_status_check").(bool) {
+		err = dnsZoneV2WaitForStatus(dnsClient, d.Id(), []string{"ACTIVE"}, []string{"PENDING"}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack DNS Zone %s to update: %s", d.Id(), err)
+		}
 	}
 
-	_, err = stateConf.WaitForState()
-
 	return resourceDNSZoneV2Read(d, meta)
 }
 
@@ -220,23 +229,26 @@ func resourceDNSZoneV2Delete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
 	}
 
-	_, err = zones.Delete(dnsClient, d.Id()).Extract()
+	// A zone stuck in ERROR can only be removed with a forced delete, so
+	// ERROR is then an acceptable outcome of the wait as well.
+	target := []string{"DELETED"}
+	if d.Get("force_delete").(bool) {
+		err = dnsZoneV2ForceDelete(dnsClient, d.Id())
+		target = append(target, "ERROR")
+	} else {
+		_, err = zones.Delete(dnsClient, d.Id()).Extract()
+	}
 	if err != nil {
-		return fmt.Errorf("Error deleting OpenStack DNS Zone: %s", err)
+		return CheckDeleted(d, err, "Error deleting OpenStack DNS Zone")
 	}
 
-	log.Printf("[DEBUG] Waiting for DNS Zone (%s) to become available", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Target:     []string{"DELETED"},
-		Pending:    []string{"ACTIVE", "PENDING"},
-		Refresh:    waitForDNSZone(dnsClient, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      5 * time.Second,
-		MinTimeout: 3 * time.Second,
+	if !d.Get("disable_status_check").(bool) {
+		err = dnsZoneV2WaitForStatus(dnsClient, d.Id(), target, []string{"ACTIVE", "PENDING"}, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack DNS Zone %s to be deleted: %s", d.Id(), err)
+		}
 	}
 
-	_, err = stateConf.WaitForState()
-
 	d.SetId("")
 	return nil
 }
@@ -258,19 +270,3 @@ func resourceDNSZoneV2ValidType(v interface{}, k string) (ws []string, errors []
 	errors = append(errors, err)
 	return
 }
-
-func waitForDNSZone(dnsClient *gophercloud.ServiceClient, zoneId string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		zone, err := zones.Get(dnsClient, zoneId).Extract()
-		if err != nil {
-			if _, ok := err.(gophercloud.ErrDefault404); ok {
-				return zone, "DELETED", nil
-			}
-
-			return nil, "", err
-		}
-
-		log.Printf("[DEBUG] OpenStack DNS Zone (%s) current status: %s", zone.ID, zone.Status)
-		return zone, zone.Status, nil
-	}
-}
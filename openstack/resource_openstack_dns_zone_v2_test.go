@@ -28,6 +28,8 @@ func TestAccDNSV2Zone_basic(t *testing.T) {
 					testAccCheckDNSV2ZoneExists("openstack_dns_zone_v2.zone_1", &zone),
 					resource.TestCheckResourceAttr(
 						"openstack_dns_zone_v2.zone_1", "description", "a zone"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_dns_zone_v2.zone_1", "nameservers.0"),
 				),
 			},
 			resource.TestStep{
@@ -86,6 +88,27 @@ func TestAccDNSV2Zone_timeout(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2Zone_disableStatusCheck(t *testing.T) {
+	var zone zones.Zone
+	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNSZoneV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2Zone_disableStatusCheck(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists("openstack_dns_zone_v2.zone_1", &zone),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_zone_v2.zone_1", "disable_status_check", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDNSV2ZoneDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
@@ -194,3 +217,14 @@ func testAccDNSV2Zone_timeout(zoneName string) string {
 		}
 	`, zoneName)
 }
+
+func testAccDNSV2Zone_disableStatusCheck(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email1@example.com"
+			ttl = 3000
+			disable_status_check = true
+		}
+	`, zoneName)
+}
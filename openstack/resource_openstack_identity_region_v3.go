@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIdentityRegionV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityRegionV3Create,
+		Read:   resourceIdentityRegionV3Read,
+		Update: resourceIdentityRegionV3Update,
+		Delete: resourceIdentityRegionV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"parent_region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityRegionV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	createOpts := IdentityRegionCreateOpts{
+		ID:             d.Get("region_id").(string),
+		Description:    d.Get("description").(string),
+		ParentRegionID: d.Get("parent_region_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	region, err := identityRegionV3Create(identityClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity region: %s", err)
+	}
+	log.Printf("[INFO] Region ID: %s", region.ID)
+
+	d.SetId(region.ID)
+
+	return resourceIdentityRegionV3Read(d, meta)
+}
+
+func resourceIdentityRegionV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	region, err := identityRegionV3Get(identityClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "identity region")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack identity region %s: %+v", d.Id(), region)
+
+	d.Set("region_id", region.ID)
+	d.Set("description", region.Description)
+	d.Set("parent_region_id", region.ParentRegionID)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceIdentityRegionV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var updateOpts IdentityRegionUpdateOpts
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("parent_region_id") {
+		parentRegionID := d.Get("parent_region_id").(string)
+		updateOpts.ParentRegionID = &parentRegionID
+	}
+
+	log.Printf("[DEBUG] Updating OpenStack identity region %s with options: %+v", d.Id(), updateOpts)
+
+	if err := identityRegionV3Update(identityClient, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack identity region: %s", err)
+	}
+
+	return resourceIdentityRegionV3Read(d, meta)
+}
+
+func resourceIdentityRegionV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	if err := identityRegionV3Delete(identityClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "identity region")
+	}
+
+	d.SetId("")
+	return nil
+}
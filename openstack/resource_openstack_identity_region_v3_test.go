@@ -0,0 +1,121 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIdentityV3Region_basic(t *testing.T) {
+	var region IdentityRegion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3RegionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Region_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3RegionExists("openstack_identity_region_v3.region_2", &region),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_region_v3.region_2", "region_id", "tf-test-region-2"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_region_v3.region_2", "parent_region_id", "tf-test-region-1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3Region_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_identity_region_v3.region_2", "description", "region_2 updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_region_v3.region_2", "parent_region_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3RegionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_region_v3" {
+			continue
+		}
+
+		_, err := identityRegionV3Get(identityClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Region still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3RegionExists(n string, region *IdentityRegion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := identityRegionV3Get(identityClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Region not found")
+		}
+
+		*region = *found
+
+		return nil
+	}
+}
+
+const testAccIdentityV3Region_basic = `
+resource "openstack_identity_region_v3" "region_1" {
+  region_id = "tf-test-region-1"
+  description = "region_1"
+}
+
+resource "openstack_identity_region_v3" "region_2" {
+  region_id = "tf-test-region-2"
+  description = "region_2"
+  parent_region_id = "${openstack_identity_region_v3.region_1.region_id}"
+}
+`
+
+const testAccIdentityV3Region_update = `
+resource "openstack_identity_region_v3" "region_1" {
+  region_id = "tf-test-region-1"
+  description = "region_1"
+}
+
+resource "openstack_identity_region_v3" "region_2" {
+  region_id = "tf-test-region-2"
+  description = "region_2 updated"
+}
+`
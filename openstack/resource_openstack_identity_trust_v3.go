@@ -0,0 +1,191 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIdentityTrustV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityTrustV3Create,
+		Read:   resourceIdentityTrustV3Read,
+		Delete: resourceIdentityTrustV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"trustor_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"trustee_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"roles": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"impersonation": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"expires_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q must be an RFC3339 timestamp: %s", k, err))
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceIdentityTrustV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	// Keystone only lets the trustor create a trust, so default to the user
+	// the provider is authenticated as.
+	trustorUserID := d.Get("trustor_user_id").(string)
+	if trustorUserID == "" {
+		trustorUserID, err = identityV3TokenUserID(identityClient)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the user of the OpenStack identity token: %s", err)
+		}
+	}
+
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		projectID = identityV3TokenProjectID(config, GetRegion(d))
+	}
+
+	var roles []IdentityTrustRole
+	for _, v := range d.Get("roles").(*schema.Set).List() {
+		roles = append(roles, IdentityTrustRole{Name: v.(string)})
+	}
+
+	createOpts := IdentityTrustCreateOpts{
+		TrustorUserID: trustorUserID,
+		TrusteeUserID: d.Get("trustee_user_id").(string),
+		ProjectID:     projectID,
+		Impersonation: d.Get("impersonation").(bool),
+		ExpiresAt:     d.Get("expires_at").(string),
+		Roles:         roles,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	trust, err := identityTrustV3Create(identityClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity trust: %s", err)
+	}
+	log.Printf("[INFO] Trust ID: %s", trust.ID)
+
+	d.SetId(trust.ID)
+
+	return resourceIdentityTrustV3Read(d, meta)
+}
+
+func resourceIdentityTrustV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	trust, err := identityTrustV3Get(identityClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "identity trust")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack identity trust %s: %+v", d.Id(), trust)
+
+	roles := make([]string, 0, len(trust.Roles))
+	for _, role := range trust.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	d.Set("trustor_user_id", trust.TrustorUserID)
+	d.Set("trustee_user_id", trust.TrusteeUserID)
+	d.Set("project_id", trust.ProjectID)
+	d.Set("roles", roles)
+	d.Set("impersonation", trust.Impersonation)
+	d.Set("region", GetRegion(d))
+
+	// Keystone returns the expiry with microseconds, so keep the configured
+	// timestamp when it's the same time.
+	expiresAt := trust.ExpiresAt
+	if v, ok := d.GetOk("expires_at"); ok && identityTrustV3SameTime(v.(string), expiresAt) {
+		expiresAt = v.(string)
+	}
+	d.Set("expires_at", expiresAt)
+
+	return nil
+}
+
+func resourceIdentityTrustV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	if err := identityTrustV3Delete(identityClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "identity trust")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func identityTrustV3SameTime(a, b string) bool {
+	ta, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+
+	tb, err := time.Parse(time.RFC3339Nano, b)
+	if err != nil {
+		return false
+	}
+
+	return ta.Equal(tb)
+}
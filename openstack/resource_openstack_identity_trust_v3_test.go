@@ -0,0 +1,105 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIdentityV3Trust_basic(t *testing.T) {
+	var trust IdentityTrust
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+			testAccPreCheckTrustee(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3TrustDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Trust_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3TrustExists("openstack_identity_trust_v3.trust_1", &trust),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "trustee_user_id", OS_TRUSTEE_USER_ID),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "roles.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "impersonation", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "expires_at", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_identity_trust_v3.trust_1", "trustor_user_id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_identity_trust_v3.trust_1", "project_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3TrustDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_trust_v3" {
+			continue
+		}
+
+		_, err := identityTrustV3Get(identityClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Trust still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3TrustExists(n string, trust *IdentityTrust) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := identityTrustV3Get(identityClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Trust not found")
+		}
+
+		*trust = *found
+
+		return nil
+	}
+}
+
+var testAccIdentityV3Trust_basic = fmt.Sprintf(`
+resource "openstack_identity_trust_v3" "trust_1" {
+  trustee_user_id = "%s"
+  roles           = ["admin"]
+  impersonation   = true
+  expires_at      = "2030-01-01T00:00:00Z"
+}
+`, OS_TRUSTEE_USER_ID)
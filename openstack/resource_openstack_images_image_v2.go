@@ -58,6 +58,16 @@ func resourceImagesImageV2() *schema.Resource {
 				ValidateFunc: resourceImagesImageV2ValidateDiskFormat,
 			},
 
+			"hash_algo": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hash_value": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"file": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -156,6 +166,11 @@ func resourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"virtual_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"visibility": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -251,13 +266,19 @@ func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack image client: %s", err)
 	}
 
-	img, err := images.Get(imageClient, d.Id()).Extract()
+	r := images.Get(imageClient, d.Id())
+	img, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "image")
 	}
 
 	log.Printf("[DEBUG] Retrieved Image %s: %#v", d.Id(), img)
 
+	var ext imageExtensions
+	if err := r.ExtractInto(&ext); err != nil {
+		return fmt.Errorf("Error extracting virtual_size of image %s: %s", d.Id(), err)
+	}
+
 	d.Set("owner", img.Owner)
 	d.Set("status", img.Status)
 	d.Set("file", img.File)
@@ -277,6 +298,9 @@ func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("size_bytes", img.SizeBytes)
 	d.Set("tags", img.Tags)
 	d.Set("visibility", img.Visibility)
+	d.Set("virtual_size", ext.VirtualSize)
+	d.Set("hash_algo", ext.HashAlgo)
+	d.Set("hash_value", ext.HashValue)
 	return nil
 }
 
@@ -363,6 +387,15 @@ func validatePositiveInt(v interface{}, k string) (ws []string, errors []error)
 	return
 }
 
+func validateNonNegativeInt(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value >= 0 {
+		return
+	}
+	errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	return
+}
+
 var DiskFormats = [9]string{"ami", "ari", "aki", "vhd", "vmdk", "raw", "qcow2", "vdi", "iso"}
 
 func resourceImagesImageV2ValidateDiskFormat(v interface{}, k string) (ws []string, errors []error) {
@@ -389,6 +422,16 @@ func resourceImagesImageV2ValidateContainerFormat(v interface{}, k string) (ws [
 	return
 }
 
+// imageExtensions are the attributes of an image which images.Image doesn't
+// include. virtual_size stays null until Glance has inspected the image data,
+// and the os_hash attributes are only returned since Glance Rocky.
+type imageExtensions struct {
+	ID          string `json:"id"`
+	VirtualSize int64  `json:"virtual_size"`
+	HashAlgo    string `json:"os_hash_algo"`
+	HashValue   string `json:"os_hash_value"`
+}
+
 func resourceImagesImageV2VisibilityFromString(v string) images.ImageVisibility {
 	switch v {
 	case "public":
@@ -0,0 +1,239 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesMetadefNamespaceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesMetadefNamespaceV2Create,
+		Read:   resourceImagesMetadefNamespaceV2Read,
+		Update: resourceImagesMetadefNamespaceV2Update,
+		Delete: resourceImagesMetadefNamespaceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"visibility": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: resourceImagesMetadefNamespaceV2ValidateVisibility,
+			},
+
+			"protected": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"resource_type_association": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"prefix": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"properties_target": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"owner": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefNamespaceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	createOpts := resourceImagesMetadefNamespaceV2Opts(d)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	if err := imagesMetadefNamespaceV2Create(imageClient, createOpts); err != nil {
+		return fmt.Errorf("Error creating OpenStack metadef namespace: %s", err)
+	}
+
+	d.SetId(createOpts.Namespace)
+
+	for _, association := range resourceImagesMetadefNamespaceV2Associations(d.Get("resource_type_association").(*schema.Set)) {
+		log.Printf("[DEBUG] Associating metadef namespace %s with %#v", d.Id(), association)
+		if err := imagesMetadefResourceTypeAssociationV2Create(imageClient, d.Id(), association); err != nil {
+			return fmt.Errorf("Error associating OpenStack metadef namespace %s with resource type %s: %s",
+				d.Id(), association.Name, err)
+		}
+	}
+
+	return resourceImagesMetadefNamespaceV2Read(d, meta)
+}
+
+func resourceImagesMetadefNamespaceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, err := imagesMetadefNamespaceV2Get(imageClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "metadef namespace")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack metadef namespace %s: %+v", d.Id(), namespace)
+
+	associations := make([]map[string]interface{}, len(namespace.ResourceTypeAssociations))
+	for i, association := range namespace.ResourceTypeAssociations {
+		associations[i] = map[string]interface{}{
+			"name":              association.Name,
+			"prefix":            association.Prefix,
+			"properties_target": association.PropertiesTarget,
+		}
+	}
+
+	d.Set("namespace", namespace.Namespace)
+	d.Set("display_name", namespace.DisplayName)
+	d.Set("description", namespace.Description)
+	d.Set("visibility", namespace.Visibility)
+	d.Set("protected", namespace.Protected)
+	d.Set("resource_type_association", associations)
+	d.Set("owner", namespace.Owner)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceImagesMetadefNamespaceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	if d.HasChange("display_name") || d.HasChange("description") || d.HasChange("visibility") || d.HasChange("protected") {
+		// The namespace is replaced by the request, so all of its
+		// attributes are sent.
+		updateOpts := resourceImagesMetadefNamespaceV2Opts(d)
+
+		log.Printf("[DEBUG] Updating OpenStack metadef namespace %s with options: %#v", d.Id(), updateOpts)
+		if err := imagesMetadefNamespaceV2Update(imageClient, d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("Error updating OpenStack metadef namespace %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("resource_type_association") {
+		o, n := d.GetChange("resource_type_association")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		for _, association := range resourceImagesMetadefNamespaceV2Associations(oldSet.Difference(newSet)) {
+			log.Printf("[DEBUG] Dissociating metadef namespace %s from %s", d.Id(), association.Name)
+			err := imagesMetadefResourceTypeAssociationV2Delete(imageClient, d.Id(), association.Name)
+			if err != nil {
+				return fmt.Errorf("Error dissociating OpenStack metadef namespace %s from resource type %s: %s",
+					d.Id(), association.Name, err)
+			}
+		}
+
+		for _, association := range resourceImagesMetadefNamespaceV2Associations(newSet.Difference(oldSet)) {
+			log.Printf("[DEBUG] Associating metadef namespace %s with %#v", d.Id(), association)
+			if err := imagesMetadefResourceTypeAssociationV2Create(imageClient, d.Id(), association); err != nil {
+				return fmt.Errorf("Error associating OpenStack metadef namespace %s with resource type %s: %s",
+					d.Id(), association.Name, err)
+			}
+		}
+	}
+
+	return resourceImagesMetadefNamespaceV2Read(d, meta)
+}
+
+func resourceImagesMetadefNamespaceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	if err := imagesMetadefNamespaceV2Delete(imageClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "metadef namespace")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceImagesMetadefNamespaceV2ValidateVisibility(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "public" && value != "private" {
+		errors = append(errors, fmt.Errorf("%s must be one of [public private]", k))
+	}
+	return
+}
+
+func resourceImagesMetadefNamespaceV2Opts(d *schema.ResourceData) ImagesMetadefNamespaceOpts {
+	protected := d.Get("protected").(bool)
+
+	return ImagesMetadefNamespaceOpts{
+		Namespace:   d.Get("namespace").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Visibility:  d.Get("visibility").(string),
+		Protected:   &protected,
+	}
+}
+
+func resourceImagesMetadefNamespaceV2Associations(set *schema.Set) []ImagesMetadefResourceTypeAssociation {
+	associations := make([]ImagesMetadefResourceTypeAssociation, set.Len())
+	for i, raw := range set.List() {
+		rawMap := raw.(map[string]interface{})
+		associations[i] = ImagesMetadefResourceTypeAssociation{
+			Name:             rawMap["name"].(string),
+			Prefix:           rawMap["prefix"].(string),
+			PropertiesTarget: rawMap["properties_target"].(string),
+		}
+	}
+
+	return associations
+}
@@ -0,0 +1,129 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesMetadefNamespaceV2_basic(t *testing.T) {
+	var namespace ImagesMetadefNamespace
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesMetadefNamespaceV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesMetadefNamespaceV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefNamespaceV2Exists("openstack_images_metadef_namespace_v2.namespace_1", &namespace),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "display_name", "Namespace 1"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "resource_type_association.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesMetadefNamespaceV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefNamespaceV2Exists("openstack_images_metadef_namespace_v2.namespace_1", &namespace),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "display_name", "Namespace 1 updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "visibility", "public"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "resource_type_association.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefNamespaceV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_namespace_v2" {
+			continue
+		}
+
+		_, err := imagesMetadefNamespaceV2Get(imageClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Metadef namespace still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesMetadefNamespaceV2Exists(n string, namespace *ImagesMetadefNamespace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		found, err := imagesMetadefNamespaceV2Get(imageClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.Namespace != rs.Primary.ID {
+			return fmt.Errorf("Metadef namespace not found")
+		}
+
+		*namespace = *found
+
+		return nil
+	}
+}
+
+const testAccImagesMetadefNamespaceV2_basic = `
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "TF::Test::Namespace1"
+  display_name = "Namespace 1"
+  description = "Namespace for acceptance tests"
+
+  resource_type_association {
+    name = "OS::Glance::Image"
+  }
+}
+`
+
+const testAccImagesMetadefNamespaceV2_update = `
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "TF::Test::Namespace1"
+  display_name = "Namespace 1 updated"
+  description = "Namespace for acceptance tests"
+  visibility = "public"
+
+  resource_type_association {
+    name = "OS::Glance::Image"
+  }
+
+  resource_type_association {
+    name = "OS::Nova::Flavor"
+    prefix = "tf:"
+  }
+}
+`
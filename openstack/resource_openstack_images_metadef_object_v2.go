@@ -0,0 +1,217 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesMetadefObjectV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesMetadefObjectV2Create,
+		Read:   resourceImagesMetadefObjectV2Read,
+		Update: resourceImagesMetadefObjectV2Update,
+		Delete: resourceImagesMetadefObjectV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"required": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"properties": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: resourceImagesMetadefObjectV2ValidateProperties,
+				StateFunc:    resourceImagesMetadefObjectV2NormalizeProperties,
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefObjectV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	object, err := resourceImagesMetadefObjectV2Opts(d)
+	if err != nil {
+		return err
+	}
+	namespace := d.Get("namespace").(string)
+
+	log.Printf("[DEBUG] Create Options: %#v", object)
+	if err := imagesMetadefObjectV2Create(imageClient, namespace, object); err != nil {
+		return fmt.Errorf("Error creating OpenStack metadef object: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", namespace, object.Name))
+
+	return resourceImagesMetadefObjectV2Read(d, meta)
+}
+
+func resourceImagesMetadefObjectV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	object, err := imagesMetadefObjectV2Get(imageClient, namespace, name)
+	if err != nil {
+		return CheckDeleted(d, err, "metadef object")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack metadef object %s: %+v", d.Id(), object)
+
+	properties := ""
+	if len(object.Properties) > 0 {
+		b, err := json.Marshal(object.Properties)
+		if err != nil {
+			return fmt.Errorf("Error encoding the properties of OpenStack metadef object %s: %s", d.Id(), err)
+		}
+		properties = string(b)
+	}
+
+	d.Set("namespace", namespace)
+	d.Set("name", object.Name)
+	d.Set("description", object.Description)
+	d.Set("required", object.Required)
+	d.Set("properties", properties)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceImagesMetadefObjectV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	// The object is replaced by the request, so all of its attributes are
+	// sent.
+	object, err := resourceImagesMetadefObjectV2Opts(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating OpenStack metadef object %s with options: %#v", d.Id(), object)
+	if err := imagesMetadefObjectV2Update(imageClient, d.Get("namespace").(string), object); err != nil {
+		return fmt.Errorf("Error updating OpenStack metadef object %s: %s", d.Id(), err)
+	}
+
+	return resourceImagesMetadefObjectV2Read(d, meta)
+}
+
+func resourceImagesMetadefObjectV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := imagesMetadefObjectV2Delete(imageClient, namespace, name); err != nil {
+		return CheckDeleted(d, err, "metadef object")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceImagesMetadefObjectV2Opts(d *schema.ResourceData) (ImagesMetadefObject, error) {
+	object := ImagesMetadefObject{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	for _, v := range d.Get("required").([]interface{}) {
+		object.Required = append(object.Required, v.(string))
+	}
+
+	if v := d.Get("properties").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &object.Properties); err != nil {
+			return object, fmt.Errorf("Error decoding properties: %s", err)
+		}
+	}
+
+	return object, nil
+}
+
+func resourceImagesMetadefObjectV2ValidateProperties(v interface{}, k string) (ws []string, errors []error) {
+	var properties map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &properties); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
+
+// resourceImagesMetadefObjectV2NormalizeProperties re-encodes the properties
+// so that whitespace and key order don't cause a diff.
+func resourceImagesMetadefObjectV2NormalizeProperties(v interface{}) string {
+	var properties map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &properties); err != nil {
+		return v.(string)
+	}
+
+	b, _ := json.Marshal(properties)
+	return string(b)
+}
+
+// parseImagesMetadefId splits the ID of a metadef object or property into
+// its namespace and name.
+func parseImagesMetadefId(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("Unable to determine metadef ID")
+	}
+
+	namespace := idParts[0]
+	name := idParts[1]
+
+	return namespace, name, nil
+}
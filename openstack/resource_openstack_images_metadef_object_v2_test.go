@@ -0,0 +1,147 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesMetadefObjectV2_basic(t *testing.T) {
+	var object ImagesMetadefObject
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesMetadefObjectV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesMetadefObjectV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefObjectV2Exists("openstack_images_metadef_object_v2.object_1", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "properties",
+						`{"tf:size":{"title":"Size","type":"integer"}}`),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesMetadefObjectV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefObjectV2Exists("openstack_images_metadef_object_v2.object_1", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "description", "Updated object"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "required.0", "tf:size"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefObjectV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_object_v2" {
+			continue
+		}
+
+		namespace, name, err := parseImagesMetadefId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imagesMetadefObjectV2Get(imageClient, namespace, name)
+		if err == nil {
+			return fmt.Errorf("Metadef object still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesMetadefObjectV2Exists(n string, object *ImagesMetadefObject) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		namespace, name, err := parseImagesMetadefId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := imagesMetadefObjectV2Get(imageClient, namespace, name)
+		if err != nil {
+			return err
+		}
+
+		if found.Name != name {
+			return fmt.Errorf("Metadef object not found")
+		}
+
+		*object = *found
+
+		return nil
+	}
+}
+
+const testAccImagesMetadefObjectV2_basic = `
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "TF::Test::Namespace1"
+}
+
+resource "openstack_images_metadef_object_v2" "object_1" {
+  namespace = "${openstack_images_metadef_namespace_v2.namespace_1.namespace}"
+  name = "object_1"
+  description = "Object for acceptance tests"
+  properties = <<EOF
+{
+  "tf:size": {
+    "type": "integer",
+    "title": "Size"
+  }
+}
+EOF
+}
+`
+
+const testAccImagesMetadefObjectV2_update = `
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "TF::Test::Namespace1"
+}
+
+resource "openstack_images_metadef_object_v2" "object_1" {
+  namespace = "${openstack_images_metadef_namespace_v2.namespace_1.namespace}"
+  name = "object_1"
+  description = "Updated object"
+  required = ["tf:size"]
+  properties = <<EOF
+{
+  "tf:size": {
+    "type": "integer",
+    "title": "Size"
+  }
+}
+EOF
+}
+`
@@ -0,0 +1,181 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesMetadefPropertyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesMetadefPropertyV2Create,
+		Read:   resourceImagesMetadefPropertyV2Read,
+		Update: resourceImagesMetadefPropertyV2Update,
+		Delete: resourceImagesMetadefPropertyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"title": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enum": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"pattern": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"readonly": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefPropertyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	property := resourceImagesMetadefPropertyV2Opts(d)
+	namespace := d.Get("namespace").(string)
+
+	log.Printf("[DEBUG] Create Options: %#v", property)
+	if err := imagesMetadefPropertyV2Create(imageClient, namespace, property); err != nil {
+		return fmt.Errorf("Error creating OpenStack metadef property: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", namespace, property.Name))
+
+	return resourceImagesMetadefPropertyV2Read(d, meta)
+}
+
+func resourceImagesMetadefPropertyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	property, err := imagesMetadefPropertyV2Get(imageClient, namespace, name)
+	if err != nil {
+		return CheckDeleted(d, err, "metadef property")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack metadef property %s: %+v", d.Id(), property)
+
+	d.Set("namespace", namespace)
+	d.Set("name", property.Name)
+	d.Set("title", property.Title)
+	d.Set("type", property.Type)
+	d.Set("description", property.Description)
+	d.Set("enum", property.Enum)
+	d.Set("pattern", property.Pattern)
+	d.Set("readonly", property.ReadOnly)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceImagesMetadefPropertyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	// The property is replaced by the request, so all of its attributes are
+	// sent.
+	property := resourceImagesMetadefPropertyV2Opts(d)
+
+	log.Printf("[DEBUG] Updating OpenStack metadef property %s with options: %#v", d.Id(), property)
+	if err := imagesMetadefPropertyV2Update(imageClient, d.Get("namespace").(string), property); err != nil {
+		return fmt.Errorf("Error updating OpenStack metadef property %s: %s", d.Id(), err)
+	}
+
+	return resourceImagesMetadefPropertyV2Read(d, meta)
+}
+
+func resourceImagesMetadefPropertyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := imagesMetadefPropertyV2Delete(imageClient, namespace, name); err != nil {
+		return CheckDeleted(d, err, "metadef property")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceImagesMetadefPropertyV2Opts(d *schema.ResourceData) ImagesMetadefProperty {
+	property := ImagesMetadefProperty{
+		Name:        d.Get("name").(string),
+		Title:       d.Get("title").(string),
+		Type:        d.Get("type").(string),
+		Description: d.Get("description").(string),
+		Pattern:     d.Get("pattern").(string),
+		ReadOnly:    d.Get("readonly").(bool),
+	}
+
+	for _, v := range d.Get("enum").([]interface{}) {
+		property.Enum = append(property.Enum, v.(string))
+	}
+
+	return property
+}
@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesMetadefPropertyV2_basic(t *testing.T) {
+	var property ImagesMetadefProperty
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesMetadefPropertyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesMetadefPropertyV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefPropertyV2Exists("openstack_images_metadef_property_v2.property_1", &property),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "enum.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesMetadefPropertyV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefPropertyV2Exists("openstack_images_metadef_property_v2.property_1", &property),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "title", "Tier updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "enum.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefPropertyV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_property_v2" {
+			continue
+		}
+
+		namespace, name, err := parseImagesMetadefId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imagesMetadefPropertyV2Get(imageClient, namespace, name)
+		if err == nil {
+			return fmt.Errorf("Metadef property still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesMetadefPropertyV2Exists(n string, property *ImagesMetadefProperty) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		namespace, name, err := parseImagesMetadefId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := imagesMetadefPropertyV2Get(imageClient, namespace, name)
+		if err != nil {
+			return err
+		}
+
+		if found.Name != name {
+			return fmt.Errorf("Metadef property not found")
+		}
+
+		*property = *found
+
+		return nil
+	}
+}
+
+const testAccImagesMetadefPropertyV2_basic = `
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "TF::Test::Namespace1"
+}
+
+resource "openstack_images_metadef_property_v2" "property_1" {
+  namespace = "${openstack_images_metadef_namespace_v2.namespace_1.namespace}"
+  name = "tf:tier"
+  title = "Tier"
+  type = "string"
+  enum = ["gold", "silver"]
+}
+`
+
+const testAccImagesMetadefPropertyV2_update = `
+resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+  namespace = "TF::Test::Namespace1"
+}
+
+resource "openstack_images_metadef_property_v2" "property_1" {
+  namespace = "${openstack_images_metadef_namespace_v2.namespace_1.namespace}"
+  name = "tf:tier"
+  title = "Tier updated"
+  type = "string"
+  enum = ["gold", "silver", "bronze"]
+}
+`
@@ -0,0 +1,189 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceInfraOptimAuditTemplateV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfraOptimAuditTemplateV1Create,
+		Read:   resourceInfraOptimAuditTemplateV1Read,
+		Update: resourceInfraOptimAuditTemplateV1Update,
+		Delete: resourceInfraOptimAuditTemplateV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"goal": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"strategy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceInfraOptimAuditTemplateV1ValidateScope,
+				StateFunc:    resourceInfraOptimAuditTemplateV1NormalizeScope,
+			},
+		},
+	}
+}
+
+func resourceInfraOptimAuditTemplateV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	createOpts := InfraOptimAuditTemplate{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Goal:        d.Get("goal").(string),
+		Strategy:    d.Get("strategy").(string),
+	}
+
+	if v, ok := d.GetOk("scope"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &createOpts.Scope); err != nil {
+			return fmt.Errorf("Error decoding scope: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	template, err := infraOptimAuditTemplateV1Create(infraOptimClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack audit template: %s", err)
+	}
+	log.Printf("[INFO] Audit template ID: %s", template.UUID)
+
+	d.SetId(template.UUID)
+
+	return resourceInfraOptimAuditTemplateV1Read(d, meta)
+}
+
+func resourceInfraOptimAuditTemplateV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	template, err := infraOptimAuditTemplateV1Get(infraOptimClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "audit template")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack audit template %s: %+v", d.Id(), template)
+
+	// Keep the goal and strategy UUIDs if they were given instead of names.
+	if d.Get("goal").(string) != template.GoalUUID {
+		d.Set("goal", template.GoalName)
+	}
+	if d.Get("strategy").(string) != template.StrategyUUID {
+		d.Set("strategy", template.StrategyName)
+	}
+
+	if len(template.Scope) > 0 {
+		scope, err := json.Marshal(template.Scope)
+		if err != nil {
+			return fmt.Errorf("Error encoding scope: %s", err)
+		}
+		d.Set("scope", string(scope))
+	} else {
+		d.Set("scope", "")
+	}
+
+	d.Set("name", template.Name)
+	d.Set("description", template.Description)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceInfraOptimAuditTemplateV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	var patches []JSONPatch
+	if d.HasChange("name") {
+		patches = append(patches, JSONPatch{Op: "replace", Path: "/name", Value: d.Get("name").(string)})
+	}
+	if d.HasChange("description") {
+		patches = append(patches, JSONPatch{Op: "replace", Path: "/description", Value: d.Get("description").(string)})
+	}
+
+	log.Printf("[DEBUG] Updating OpenStack audit template %s with patches: %+v", d.Id(), patches)
+
+	if err := infraOptimAuditTemplateV1Update(infraOptimClient, d.Id(), patches); err != nil {
+		return fmt.Errorf("Error updating OpenStack audit template: %s", err)
+	}
+
+	return resourceInfraOptimAuditTemplateV1Read(d, meta)
+}
+
+func resourceInfraOptimAuditTemplateV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	if err := infraOptimAuditTemplateV1Delete(infraOptimClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "audit template")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceInfraOptimAuditTemplateV1ValidateScope(v interface{}, k string) (ws []string, errors []error) {
+	var scope []interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &scope); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON list: %s", k, err))
+	}
+	return
+}
+
+func resourceInfraOptimAuditTemplateV1NormalizeScope(v interface{}) string {
+	var scope []interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &scope); err != nil {
+		return v.(string)
+	}
+
+	b, _ := json.Marshal(scope)
+	return string(b)
+}
@@ -0,0 +1,114 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccInfraOptimV1AuditTemplate_basic(t *testing.T) {
+	var template InfraOptimAuditTemplate
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInfraOptimV1AuditTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInfraOptimV1AuditTemplate_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInfraOptimV1AuditTemplateExists(
+						"openstack_infraoptim_audit_template_v1.template_1", &template),
+					resource.TestCheckResourceAttr(
+						"openstack_infraoptim_audit_template_v1.template_1", "goal", "server_consolidation"),
+					resource.TestCheckResourceAttr(
+						"openstack_infraoptim_audit_template_v1.template_1", "strategy", "vm_workload_consolidation"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccInfraOptimV1AuditTemplate_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_infraoptim_audit_template_v1.template_1", "name", "template_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_infraoptim_audit_template_v1.template_1", "description", "Consolidate servers"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInfraOptimV1AuditTemplateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_infraoptim_audit_template_v1" {
+			continue
+		}
+
+		_, err := infraOptimAuditTemplateV1Get(infraOptimClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Audit template still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckInfraOptimV1AuditTemplateExists(n string, template *InfraOptimAuditTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		infraOptimClient, err := config.infraOptimV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+		}
+
+		found, err := infraOptimAuditTemplateV1Get(infraOptimClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.UUID != rs.Primary.ID {
+			return fmt.Errorf("Audit template not found")
+		}
+
+		*template = *found
+
+		return nil
+	}
+}
+
+const testAccInfraOptimV1AuditTemplate_basic = `
+resource "openstack_infraoptim_audit_template_v1" "template_1" {
+  name     = "template_1"
+  goal     = "server_consolidation"
+  strategy = "vm_workload_consolidation"
+}
+`
+
+const testAccInfraOptimV1AuditTemplate_update = `
+resource "openstack_infraoptim_audit_template_v1" "template_1" {
+  name        = "template_1_updated"
+  description = "Consolidate servers"
+  goal        = "server_consolidation"
+  strategy    = "vm_workload_consolidation"
+}
+`
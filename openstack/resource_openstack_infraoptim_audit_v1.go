@@ -0,0 +1,219 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceInfraOptimAuditV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfraOptimAuditV1Create,
+		Read:   resourceInfraOptimAuditV1Read,
+		Delete: resourceInfraOptimAuditV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"audit_template_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"audit_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ONESHOT",
+				ValidateFunc: resourceInfraOptimAuditV1ValidateAuditType,
+			},
+
+			"interval": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"parameters": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceInfraOptimAuditV1ValidateParameters,
+				StateFunc:    resourceInfraOptimAuditV1NormalizeParameters,
+			},
+
+			"auto_trigger": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"goal": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"strategy": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInfraOptimAuditV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	createOpts := InfraOptimAudit{
+		Name:              d.Get("name").(string),
+		AuditTemplateUUID: d.Get("audit_template_id").(string),
+		AuditType:         d.Get("audit_type").(string),
+		Interval:          d.Get("interval").(string),
+		AutoTrigger:       d.Get("auto_trigger").(bool),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &createOpts.Parameters); err != nil {
+			return fmt.Errorf("Error decoding parameters: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	audit, err := infraOptimAuditV1Create(infraOptimClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack audit: %s", err)
+	}
+	log.Printf("[INFO] Audit ID: %s", audit.UUID)
+
+	d.SetId(audit.UUID)
+
+	return resourceInfraOptimAuditV1Read(d, meta)
+}
+
+func resourceInfraOptimAuditV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	audit, err := infraOptimAuditV1Get(infraOptimClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "audit")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack audit %s: %+v", d.Id(), audit)
+
+	// Watcher adds the default parameters of the strategy, so only the
+	// configured parameters are kept.
+	if v, ok := d.GetOk("parameters"); ok {
+		var configured map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &configured); err == nil {
+			parameters := make(map[string]interface{})
+			for k := range configured {
+				parameters[k] = audit.Parameters[k]
+			}
+
+			b, err := json.Marshal(parameters)
+			if err != nil {
+				return fmt.Errorf("Error encoding parameters: %s", err)
+			}
+			d.Set("parameters", string(b))
+		}
+	}
+
+	d.Set("name", audit.Name)
+	d.Set("audit_type", audit.AuditType)
+	d.Set("interval", audit.Interval)
+	d.Set("auto_trigger", audit.AutoTrigger)
+	d.Set("state", audit.State)
+	d.Set("goal", audit.GoalName)
+	d.Set("strategy", audit.StrategyName)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceInfraOptimAuditV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	audit, err := infraOptimAuditV1Get(infraOptimClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "audit")
+	}
+
+	// Audits which are still running have to be cancelled first.
+	switch audit.State {
+	case "PENDING", "ONGOING", "SUSPENDED":
+		log.Printf("[DEBUG] Cancelling OpenStack audit %s in state %s", d.Id(), audit.State)
+		patches := []JSONPatch{
+			JSONPatch{Op: "replace", Path: "/state", Value: "CANCELLED"},
+		}
+		if err := infraOptimAuditV1Update(infraOptimClient, d.Id(), patches); err != nil {
+			return fmt.Errorf("Error cancelling OpenStack audit %s: %s", d.Id(), err)
+		}
+	}
+
+	if err := infraOptimAuditV1Delete(infraOptimClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "audit")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceInfraOptimAuditV1ValidateAuditType(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "ONESHOT", "CONTINUOUS", "EVENT":
+		return
+	}
+	errors = append(errors, fmt.Errorf("Only 'ONESHOT', 'CONTINUOUS' and 'EVENT' are supported values for %q", k))
+	return
+}
+
+func resourceInfraOptimAuditV1ValidateParameters(v interface{}, k string) (ws []string, errors []error) {
+	var parameters map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &parameters); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
+
+func resourceInfraOptimAuditV1NormalizeParameters(v interface{}) string {
+	var parameters map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &parameters); err != nil {
+		return v.(string)
+	}
+
+	b, _ := json.Marshal(parameters)
+	return string(b)
+}
@@ -0,0 +1,68 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccInfraOptimV1Audit_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInfraOptimV1AuditDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccInfraOptimV1Audit_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_infraoptim_audit_v1.audit_1", "audit_type", "CONTINUOUS"),
+					resource.TestCheckResourceAttr(
+						"openstack_infraoptim_audit_v1.audit_1", "goal", "server_consolidation"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_infraoptim_audit_v1.audit_1", "state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInfraOptimV1AuditDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	infraOptimClient, err := config.infraOptimV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack infra optim client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_infraoptim_audit_v1" {
+			continue
+		}
+
+		_, err := infraOptimAuditV1Get(infraOptimClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Audit still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccInfraOptimV1Audit_basic = `
+resource "openstack_infraoptim_audit_template_v1" "template_1" {
+  name     = "template_1"
+  goal     = "server_consolidation"
+  strategy = "vm_workload_consolidation"
+}
+
+resource "openstack_infraoptim_audit_v1" "audit_1" {
+  audit_template_id = "${openstack_infraoptim_audit_template_v1.template_1.id}"
+  audit_type        = "CONTINUOUS"
+  interval          = "3600"
+}
+`
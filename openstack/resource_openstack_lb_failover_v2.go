@@ -0,0 +1,117 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceLBFailoverV2 triggers a failover of a load balancer or of one of its
+// amphorae when it is created. It manages no object in OpenStack, so changing
+// any argument, e.g. one of the triggers, fails over again.
+func resourceLBFailoverV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBFailoverV2Create,
+		Read:   resourceLBFailoverV2Read,
+		Delete: resourceLBFailoverV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"loadbalancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"amphora_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"triggers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceLBFailoverV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	lbID := d.Get("loadbalancer_id").(string)
+	if amphoraID, ok := d.GetOk("amphora_id"); ok {
+		log.Printf("[DEBUG] Failing over amphora %s of OpenStack LBaaSV2 load balancer %s", amphoraID, lbID)
+		if err := lbAmphoraV2Failover(networkingClient, amphoraID.(string)); err != nil {
+			return fmt.Errorf("Error failing over amphora %s: %s", amphoraID, err)
+		}
+		d.SetId(amphoraID.(string))
+	} else {
+		log.Printf("[DEBUG] Failing over OpenStack LBaaSV2 load balancer %s", lbID)
+		if err := lbLoadBalancerV2Failover(networkingClient, lbID); err != nil {
+			return fmt.Errorf("Error failing over OpenStack LBaaSV2 load balancer %s: %s", lbID, err)
+		}
+		d.SetId(lbID)
+	}
+
+	log.Printf("[DEBUG] Waiting for OpenStack LBaaSV2 load balancer (%s) to become available.", lbID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_UPDATE"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    waitForLoadBalancerActive(networkingClient, lbID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return err
+	}
+
+	return resourceLBFailoverV2Read(d, meta)
+}
+
+func resourceLBFailoverV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	// The failover is gone along with its load balancer.
+	_, err = loadbalancers.Get(networkingClient, d.Get("loadbalancer_id").(string)).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "LBV2 failover")
+	}
+
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceLBFailoverV2Delete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
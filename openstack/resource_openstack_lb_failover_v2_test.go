@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLBV2Failover_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2FailoverConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_failover_v2.failover_1", "id",
+						"openstack_lb_loadbalancer_v2.loadbalancer_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2FailoverConfig_amphora,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_failover_v2.failover_1", "id",
+						"data.openstack_lb_amphorae_v2.amphorae_1", "amphorae.0.id"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2FailoverConfig_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_failover_v2" "failover_1" {
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+
+  triggers {
+    generation = "1"
+  }
+}
+`, testAccLBV2AmphoraLoadBalancerConfig)
+
+var testAccLBV2FailoverConfig_amphora = fmt.Sprintf(`
+%s
+
+data "openstack_lb_amphorae_v2" "amphorae_1" {
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_failover_v2" "failover_1" {
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+  amphora_id      = "${data.openstack_lb_amphorae_v2.amphorae_1.amphorae.0.id}"
+}
+`, testAccLBV2AmphoraLoadBalancerConfig)
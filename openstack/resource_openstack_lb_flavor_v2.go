@@ -0,0 +1,145 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLBFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBFlavorV2Create,
+		Read:   resourceLBFlavorV2Read,
+		Update: resourceLBFlavorV2Update,
+		Delete: resourceLBFlavorV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"flavor_profile_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceLBFlavorV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := LBFlavorCreateOpts{
+		Name:            d.Get("name").(string),
+		Description:     d.Get("description").(string),
+		FlavorProfileID: d.Get("flavor_profile_id").(string),
+		Enabled:         &enabled,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	flavor, err := lbFlavorV2Create(networkingClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack LBaaSV2 flavor: %s", err)
+	}
+	log.Printf("[INFO] Flavor ID: %s", flavor.ID)
+
+	d.SetId(flavor.ID)
+
+	return resourceLBFlavorV2Read(d, meta)
+}
+
+func resourceLBFlavorV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	flavor, err := lbFlavorV2Get(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "LBV2 flavor")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack LBaaSV2 flavor %s: %+v", d.Id(), flavor)
+
+	d.Set("name", flavor.Name)
+	d.Set("description", flavor.Description)
+	d.Set("flavor_profile_id", flavor.FlavorProfileID)
+	d.Set("enabled", flavor.Enabled)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceLBFlavorV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts LBFlavorUpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("enabled") {
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	log.Printf("[DEBUG] Updating OpenStack LBaaSV2 flavor %s with options: %+v", d.Id(), updateOpts)
+
+	if err := lbFlavorV2Update(networkingClient, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack LBaaSV2 flavor: %s", err)
+	}
+
+	return resourceLBFlavorV2Read(d, meta)
+}
+
+func resourceLBFlavorV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := lbFlavorV2Delete(networkingClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "LBV2 flavor")
+	}
+
+	d.SetId("")
+	return nil
+}
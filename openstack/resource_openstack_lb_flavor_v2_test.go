@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2Flavor_basic(t *testing.T) {
+	var flavor LBFlavor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2FlavorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2FlavorConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2FlavorExists("openstack_lb_flavor_v2.flavor_1", &flavor),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavor_v2.flavor_1", "enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2FlavorConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavor_v2.flavor_1", "description", "flavor_1 updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavor_v2.flavor_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2FlavorDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_flavor_v2" {
+			continue
+		}
+
+		_, err := lbFlavorV2Get(networkingClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Flavor still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2FlavorExists(n string, flavor *LBFlavor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := lbFlavorV2Get(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Flavor not found")
+		}
+
+		*flavor = *found
+
+		return nil
+	}
+}
+
+const testAccLBV2FlavorConfig_basic = `
+resource "openstack_lb_flavorprofile_v2" "flavorprofile_1" {
+  name = "flavorprofile_1"
+  provider_name = "amphora"
+  flavor_data = "{\"loadbalancer_topology\": \"SINGLE\"}"
+}
+
+resource "openstack_lb_flavor_v2" "flavor_1" {
+  name = "flavor_1"
+  description = "flavor_1"
+  flavor_profile_id = "${openstack_lb_flavorprofile_v2.flavorprofile_1.id}"
+}
+`
+
+const testAccLBV2FlavorConfig_update = `
+resource "openstack_lb_flavorprofile_v2" "flavorprofile_1" {
+  name = "flavorprofile_1"
+  provider_name = "amphora"
+  flavor_data = "{\"loadbalancer_topology\": \"SINGLE\"}"
+}
+
+resource "openstack_lb_flavor_v2" "flavor_1" {
+  name = "flavor_1"
+  description = "flavor_1 updated"
+  flavor_profile_id = "${openstack_lb_flavorprofile_v2.flavorprofile_1.id}"
+  enabled = false
+}
+`
@@ -0,0 +1,156 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLBFlavorProfileV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBFlavorProfileV2Create,
+		Read:   resourceLBFlavorProfileV2Read,
+		Update: resourceLBFlavorProfileV2Update,
+		Delete: resourceLBFlavorProfileV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"provider_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"flavor_data": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceLBFlavorProfileV2ValidateFlavorData,
+				StateFunc:    resourceLBFlavorProfileV2NormalizeFlavorData,
+			},
+		},
+	}
+}
+
+func resourceLBFlavorProfileV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := LBFlavorProfileOpts{
+		Name:         d.Get("name").(string),
+		ProviderName: d.Get("provider_name").(string),
+		FlavorData:   d.Get("flavor_data").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	flavorProfile, err := lbFlavorProfileV2Create(networkingClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack LBaaSV2 flavor profile: %s", err)
+	}
+	log.Printf("[INFO] Flavor profile ID: %s", flavorProfile.ID)
+
+	d.SetId(flavorProfile.ID)
+
+	return resourceLBFlavorProfileV2Read(d, meta)
+}
+
+func resourceLBFlavorProfileV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	flavorProfile, err := lbFlavorProfileV2Get(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "LBV2 flavor profile")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack LBaaSV2 flavor profile %s: %+v", d.Id(), flavorProfile)
+
+	d.Set("name", flavorProfile.Name)
+	d.Set("provider_name", flavorProfile.ProviderName)
+	d.Set("flavor_data", resourceLBFlavorProfileV2NormalizeFlavorData(flavorProfile.FlavorData))
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceLBFlavorProfileV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts LBFlavorProfileOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("provider_name") {
+		updateOpts.ProviderName = d.Get("provider_name").(string)
+	}
+	if d.HasChange("flavor_data") {
+		updateOpts.FlavorData = d.Get("flavor_data").(string)
+	}
+
+	log.Printf("[DEBUG] Updating OpenStack LBaaSV2 flavor profile %s with options: %+v", d.Id(), updateOpts)
+
+	if err := lbFlavorProfileV2Update(networkingClient, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack LBaaSV2 flavor profile: %s", err)
+	}
+
+	return resourceLBFlavorProfileV2Read(d, meta)
+}
+
+func resourceLBFlavorProfileV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := lbFlavorProfileV2Delete(networkingClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "LBV2 flavor profile")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceLBFlavorProfileV2ValidateFlavorData(v interface{}, k string) (ws []string, errors []error) {
+	var flavorData map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &flavorData); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
+
+// resourceLBFlavorProfileV2NormalizeFlavorData re-encodes the flavor data so
+// that whitespace and key order don't cause a diff.
+func resourceLBFlavorProfileV2NormalizeFlavorData(v interface{}) string {
+	var flavorData map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &flavorData); err != nil {
+		return v.(string)
+	}
+
+	b, _ := json.Marshal(flavorData)
+	return string(b)
+}
@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2FlavorProfile_basic(t *testing.T) {
+	var flavorProfile LBFlavorProfile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2FlavorProfileDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2FlavorProfileConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2FlavorProfileExists("openstack_lb_flavorprofile_v2.flavorprofile_1", &flavorProfile),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavorprofile_v2.flavorprofile_1", "flavor_data", `{"loadbalancer_topology":"SINGLE"}`),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2FlavorProfileConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavorprofile_v2.flavorprofile_1", "name", "flavorprofile_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_flavorprofile_v2.flavorprofile_1", "flavor_data", `{"loadbalancer_topology":"ACTIVE_STANDBY"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2FlavorProfileDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_flavorprofile_v2" {
+			continue
+		}
+
+		_, err := lbFlavorProfileV2Get(networkingClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Flavor profile still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2FlavorProfileExists(n string, flavorProfile *LBFlavorProfile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := lbFlavorProfileV2Get(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Flavor profile not found")
+		}
+
+		*flavorProfile = *found
+
+		return nil
+	}
+}
+
+const testAccLBV2FlavorProfileConfig_basic = `
+resource "openstack_lb_flavorprofile_v2" "flavorprofile_1" {
+  name = "flavorprofile_1"
+  provider_name = "amphora"
+  flavor_data = "{\"loadbalancer_topology\": \"SINGLE\"}"
+}
+`
+
+const testAccLBV2FlavorProfileConfig_update = `
+resource "openstack_lb_flavorprofile_v2" "flavorprofile_1" {
+  name = "flavorprofile_1_updated"
+  provider_name = "amphora"
+  flavor_data = "{\"loadbalancer_topology\": \"ACTIVE_STANDBY\"}"
+}
+`
@@ -100,6 +100,47 @@ func resourceListenerV2() *schema.Resource {
 				Optional: true,
 			},
 
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tls_ciphers": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tls_versions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceLBV2ValidateTLSVersion,
+				},
+				Set: schema.HashString,
+			},
+
+			"alpn_protocols": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceLBV2ValidateALPNProtocol,
+				},
+				Set: schema.HashString,
+			},
+
 			"id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -124,23 +165,32 @@ func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 			sniContainerRefs = append(sniContainerRefs, v.(string))
 		}
 	}
-	createOpts := listeners.CreateOpts{
-		Protocol:               listeners.Protocol(d.Get("protocol").(string)),
-		ProtocolPort:           d.Get("protocol_port").(int),
-		TenantID:               d.Get("tenant_id").(string),
-		LoadbalancerID:         d.Get("loadbalancer_id").(string),
-		Name:                   d.Get("name").(string),
-		DefaultPoolID:          d.Get("default_pool_id").(string),
-		Description:            d.Get("description").(string),
-		ConnLimit:              &connLimit,
-		DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
-		SniContainerRefs:       sniContainerRefs,
-		AdminStateUp:           &adminStateUp,
+	createOpts := ListenerCreateOpts{
+		CreateOpts: listeners.CreateOpts{
+			Protocol:               listeners.Protocol(d.Get("protocol").(string)),
+			ProtocolPort:           d.Get("protocol_port").(int),
+			TenantID:               d.Get("tenant_id").(string),
+			LoadbalancerID:         d.Get("loadbalancer_id").(string),
+			Name:                   d.Get("name").(string),
+			DefaultPoolID:          d.Get("default_pool_id").(string),
+			Description:            d.Get("description").(string),
+			ConnLimit:              &connLimit,
+			DefaultTlsContainerRef: d.Get("default_tls_container_ref").(string),
+			SniContainerRefs:       sniContainerRefs,
+			AdminStateUp:           &adminStateUp,
+		},
+		Tags:          resourceLBV2TagsWithDefaults(d, config),
+		TLSCiphers:    d.Get("tls_ciphers").(string),
+		TLSVersions:   resourceLBV2StringSet(d, "tls_versions"),
+		ALPNProtocols: resourceLBV2StringSet(d, "alpn_protocols"),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	listener, err := listeners.Create(networkingClient, createOpts).Extract()
 	if err != nil {
+		if lbQuotaV2IsExceeded(err) {
+			err = lbQuotaV2ExceededError(d, config, networkingClient, "listener", err)
+		}
 		return fmt.Errorf("Error creating OpenStack LBaaSV2 listener: %s", err)
 	}
 	log.Printf("[INFO] Listener ID: %s", listener.ID)
@@ -173,10 +223,14 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	listener, err := listeners.Get(networkingClient, d.Id()).Extract()
+	var result struct {
+		Listener Listener `json:"listener"`
+	}
+	err = listeners.Get(networkingClient, d.Id()).ExtractInto(&result)
 	if err != nil {
 		return CheckDeleted(d, err, "LBV2 listener")
 	}
+	listener := result.Listener
 
 	log.Printf("[DEBUG] Retrieved OpenStack LBaaSV2 listener %s: %+v", d.Id(), listener)
 
@@ -191,6 +245,10 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("connection_limit", listener.ConnLimit)
 	d.Set("sni_container_refs", listener.SniContainerRefs)
 	d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
+	d.Set("tags", resourceLBV2ReadTags(d, config, listener.Tags))
+	d.Set("tls_ciphers", listener.TLSCiphers)
+	d.Set("tls_versions", listener.TLSVersions)
+	d.Set("alpn_protocols", listener.ALPNProtocols)
 
 	return nil
 }
@@ -202,7 +260,7 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts listeners.UpdateOpts
+	var updateOpts ListenerUpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
@@ -229,10 +287,26 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
+	if d.HasChange("tags") || d.HasChange("ignore_default_tags") {
+		tags := resourceLBV2TagsWithDefaults(d, config)
+		updateOpts.Tags = &tags
+	}
+	if d.HasChange("tls_ciphers") {
+		tlsCiphers := d.Get("tls_ciphers").(string)
+		updateOpts.TLSCiphers = &tlsCiphers
+	}
+	if d.HasChange("tls_versions") {
+		tlsVersions := resourceLBV2StringSet(d, "tls_versions")
+		updateOpts.TLSVersions = &tlsVersions
+	}
+	if d.HasChange("alpn_protocols") {
+		alpnProtocols := resourceLBV2StringSet(d, "alpn_protocols")
+		updateOpts.ALPNProtocols = &alpnProtocols
+	}
 
 	log.Printf("[DEBUG] Updating OpenStack LBaaSV2 Listener %s with options: %+v", d.Id(), updateOpts)
 
-	_, err = listeners.Update(networkingClient, d.Id(), updateOpts).Extract()
+	_, err = updateListenerV2(networkingClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error updating OpenStack LBaaSV2 Listener: %s", err)
 	}
@@ -266,6 +340,20 @@ func resourceListenerV2Delete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// updateListenerV2 updates a listener. listeners.Update only accepts a
+// listeners.UpdateOpts, so the request is made here.
+func updateListenerV2(client *gophercloud.ServiceClient, id string, opts ListenerUpdateOpts) (r listeners.UpdateResult) {
+	b, err := opts.ToListenerUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "listeners", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
 func waitForListenerActive(networkingClient *gophercloud.ServiceClient, listenerID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		listener, err := listeners.Get(networkingClient, listenerID).Extract()
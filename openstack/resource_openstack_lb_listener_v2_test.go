@@ -36,6 +36,40 @@ func TestAccLBV2Listener_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Listener_tls(t *testing.T) {
+	var listener listeners.Listener
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckTLSContainer(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2ListenerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2ListenerConfig_tls,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2ListenerExists("openstack_lb_listener_v2.listener_1", &listener),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_versions.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_ciphers", "ECDHE-RSA-AES256-GCM-SHA384"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2ListenerConfig_tlsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_versions.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_listener_v2.listener_1", "tls_ciphers", "ECDHE-RSA-AES256-GCM-SHA384:ECDHE-RSA-AES128-GCM-SHA256"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2ListenerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -142,3 +176,61 @@ resource "openstack_lb_listener_v2" "listener_1" {
   loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
 }
 `
+
+var testAccLBV2ListenerConfig_tls = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "TERMINATED_HTTPS"
+  protocol_port = 443
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+  default_tls_container_ref = "%s"
+  tls_versions = ["TLSv1.2"]
+  tls_ciphers = "ECDHE-RSA-AES256-GCM-SHA384"
+}
+`, OS_TLS_CONTAINER_REF)
+
+var testAccLBV2ListenerConfig_tlsUpdate = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "TERMINATED_HTTPS"
+  protocol_port = 443
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+  default_tls_container_ref = "%s"
+  tls_versions = ["TLSv1.2", "TLSv1.3"]
+  tls_ciphers = "ECDHE-RSA-AES256-GCM-SHA384:ECDHE-RSA-AES128-GCM-SHA256"
+}
+`, OS_TLS_CONTAINER_REF)
@@ -45,7 +45,8 @@ func resourceLoadBalancerV2() *schema.Resource {
 
 			"vip_subnet_id": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -65,7 +66,36 @@ func resourceLoadBalancerV2() *schema.Resource {
 
 			"vip_port_id": &schema.Schema{
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
+			},
+
+			"additional_vips": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"ip_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"vip_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
 			"admin_state_up": &schema.Schema{
@@ -75,8 +105,16 @@ func resourceLoadBalancerV2() *schema.Resource {
 			},
 
 			"flavor": &schema.Schema{
+				Type:       schema.TypeString,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "Please use flavor_id",
+			},
+
+			"flavor_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -102,6 +140,19 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -118,21 +169,36 @@ func resourceLoadBalancerV2Create(d *schema.ResourceData, meta interface{}) erro
 		lbProvider = v.(string)
 	}
 
+	vipSubnetID := d.Get("vip_subnet_id").(string)
+	vipPortID := d.Get("vip_port_id").(string)
+	if vipSubnetID == "" && vipPortID == "" {
+		return fmt.Errorf("One of vip_subnet_id or vip_port_id must be set")
+	}
+
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := loadbalancers.CreateOpts{
-		Name:         d.Get("name").(string),
-		Description:  d.Get("description").(string),
-		VipSubnetID:  d.Get("vip_subnet_id").(string),
-		TenantID:     d.Get("tenant_id").(string),
-		VipAddress:   d.Get("vip_address").(string),
-		AdminStateUp: &adminStateUp,
-		Flavor:       d.Get("flavor").(string),
-		Provider:     lbProvider,
+	createOpts := LoadBalancerCreateOpts{
+		CreateOpts: loadbalancers.CreateOpts{
+			Name:         d.Get("name").(string),
+			Description:  d.Get("description").(string),
+			VipSubnetID:  vipSubnetID,
+			TenantID:     d.Get("tenant_id").(string),
+			VipAddress:   d.Get("vip_address").(string),
+			AdminStateUp: &adminStateUp,
+			Flavor:       d.Get("flavor").(string),
+			Provider:     lbProvider,
+		},
+		FlavorID:       d.Get("flavor_id").(string),
+		Tags:           resourceLBV2TagsWithDefaults(d, config),
+		VipPortID:      vipPortID,
+		AdditionalVips: resourceLoadBalancerV2AdditionalVips(d),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	lb, err := loadbalancers.Create(networkingClient, createOpts).Extract()
 	if err != nil {
+		if lbQuotaV2IsExceeded(err) {
+			err = lbQuotaV2ExceededError(d, config, networkingClient, "load_balancer", err)
+		}
 		return fmt.Errorf("Error creating OpenStack LoadBalancer: %s", err)
 	}
 	log.Printf("[INFO] LoadBalancer ID: %s", lb.ID)
@@ -172,10 +238,14 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	lb, err := loadbalancers.Get(networkingClient, d.Id()).Extract()
+	var result struct {
+		LoadBalancer LoadBalancer `json:"loadbalancer"`
+	}
+	err = loadbalancers.Get(networkingClient, d.Id()).ExtractInto(&result)
 	if err != nil {
 		return CheckDeleted(d, err, "LoadBalancerV2")
 	}
+	lb := result.LoadBalancer
 
 	log.Printf("[DEBUG] Retrieved OpenStack LBaaSV2 LoadBalancer %s: %+v", d.Id(), lb)
 
@@ -185,9 +255,13 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("tenant_id", lb.TenantID)
 	d.Set("vip_address", lb.VipAddress)
 	d.Set("vip_port_id", lb.VipPortID)
+	d.Set("additional_vips", resourceLoadBalancerV2FlattenAdditionalVips(lb.AdditionalVips))
+	d.Set("vip_addresses", resourceLoadBalancerV2VipAddresses(lb))
 	d.Set("admin_state_up", lb.AdminStateUp)
 	d.Set("flavor", lb.Flavor)
+	d.Set("flavor_id", lb.FlavorID)
 	d.Set("loadbalancer_provider", lb.Provider)
+	d.Set("tags", resourceLBV2ReadTags(d, config, lb.Tags))
 
 	// Get any security groups on the VIP Port
 	if lb.VipPortID != "" {
@@ -209,7 +283,7 @@ func resourceLoadBalancerV2Update(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts loadbalancers.UpdateOpts
+	var updateOpts LoadBalancerUpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
@@ -220,10 +294,14 @@ func resourceLoadBalancerV2Update(d *schema.ResourceData, meta interface{}) erro
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
+	if d.HasChange("tags") || d.HasChange("ignore_default_tags") {
+		tags := resourceLBV2TagsWithDefaults(d, config)
+		updateOpts.Tags = &tags
+	}
 
 	log.Printf("[DEBUG] Updating OpenStack LBaaSV2 LoadBalancer %s with options: %+v", d.Id(), updateOpts)
 
-	_, err = loadbalancers.Update(networkingClient, d.Id(), updateOpts).Extract()
+	_, err = updateLoadBalancerV2(networkingClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error updating OpenStack LBaaSV2 LoadBalancer: %s", err)
 	}
@@ -284,6 +362,111 @@ func resourceLoadBalancerV2SecurityGroups(networkingClient *gophercloud.ServiceC
 	return nil
 }
 
+// updateLoadBalancerV2 updates a load balancer. loadbalancers.Update only
+// accepts a loadbalancers.UpdateOpts, so the request is made here.
+func resourceLoadBalancerV2AdditionalVips(d *schema.ResourceData) []LoadBalancerAdditionalVip {
+	var vips []LoadBalancerAdditionalVip
+	for _, raw := range d.Get("additional_vips").([]interface{}) {
+		rawMap := raw.(map[string]interface{})
+		vips = append(vips, LoadBalancerAdditionalVip{
+			SubnetID:  rawMap["subnet_id"].(string),
+			IPAddress: rawMap["ip_address"].(string),
+		})
+	}
+
+	return vips
+}
+
+func resourceLoadBalancerV2FlattenAdditionalVips(vips []LoadBalancerAdditionalVip) []map[string]interface{} {
+	var result []map[string]interface{}
+	for _, vip := range vips {
+		result = append(result, map[string]interface{}{
+			"subnet_id":  vip.SubnetID,
+			"ip_address": vip.IPAddress,
+		})
+	}
+
+	return result
+}
+
+// resourceLoadBalancerV2VipAddresses returns the addresses of all the VIPs of
+// a load balancer, starting with vip_address.
+func resourceLoadBalancerV2VipAddresses(lb LoadBalancer) []string {
+	var addresses []string
+	if lb.VipAddress != "" {
+		addresses = append(addresses, lb.VipAddress)
+	}
+
+	for _, vip := range lb.AdditionalVips {
+		if vip.IPAddress != "" {
+			addresses = append(addresses, vip.IPAddress)
+		}
+	}
+
+	return addresses
+}
+
+func updateLoadBalancerV2(client *gophercloud.ServiceClient, id string, opts LoadBalancerUpdateOpts) (r loadbalancers.UpdateResult) {
+	b, err := opts.ToLoadBalancerUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "loadbalancers", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// resourceLBV2Tags returns the tags of an LBaaS v2 resource. The tag list
+// is never nil, so that all tags can be removed on update.
+func resourceLBV2Tags(d *schema.ResourceData) []string {
+	return resourceLBV2StringSet(d, "tags")
+}
+
+// resourceLBV2StringSet returns the strings of a TypeSet attribute. The
+// result is never nil.
+func resourceLBV2StringSet(d *schema.ResourceData, key string) []string {
+	raw := d.Get(key).(*schema.Set).List()
+	s := make([]string, len(raw))
+	for i, v := range raw {
+		s[i] = v.(string)
+	}
+	return s
+}
+
+func resourceLBV2ValidateTLSVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3":
+		return
+	}
+	errors = append(errors, fmt.Errorf("Unknown TLS version %q for %q", value, k))
+	return
+}
+
+func resourceLBV2ValidateALPNProtocol(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "http/1.0", "http/1.1", "h2":
+		return
+	}
+	errors = append(errors, fmt.Errorf("Unknown ALPN protocol %q for %q", value, k))
+	return
+}
+
+// resourceLBV2TagsWithDefaults returns the tags of an LBaaS v2 resource
+// together with the provider's default_tags.
+func resourceLBV2TagsWithDefaults(d *schema.ResourceData, config *Config) []string {
+	return MergeDefaultTags(config, d.Get("ignore_default_tags").(bool), resourceLBV2Tags(d))
+}
+
+// resourceLBV2ReadTags returns the tags of an LBaaS v2 resource as read from
+// the API, without the provider's default_tags.
+func resourceLBV2ReadTags(d *schema.ResourceData, config *Config, tags []string) []string {
+	return StripDefaultTags(config, d.Get("ignore_default_tags").(bool), tags, resourceLBV2Tags(d))
+}
+
 func waitForLoadBalancerActive(networkingClient *gophercloud.ServiceClient, lbID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		lb, err := loadbalancers.Get(networkingClient, lbID).Extract()
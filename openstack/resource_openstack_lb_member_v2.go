@@ -134,10 +134,14 @@ func resourceMemberV2Create(d *schema.ResourceData, meta interface{}) error {
 				log.Printf("[DEBUG] OpenStack LBaaSV2 member is still creating.")
 				return resource.RetryableError(err)
 			case gophercloud.ErrUnexpectedResponseCode:
+				if lbQuotaV2IsExceeded(err) {
+					return resource.NonRetryableError(lbQuotaV2ExceededError(d, config, networkingClient, "member", err))
+				}
 				if errCode.Actual == 409 {
 					log.Printf("[DEBUG] OpenStack LBaaSV2 member is still creating.")
 					return resource.RetryableError(err)
 				}
+				return resource.NonRetryableError(err)
 
 			default:
 				return resource.NonRetryableError(err)
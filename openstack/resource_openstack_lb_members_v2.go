@@ -0,0 +1,258 @@
+package openstack
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func resourceMembersV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMembersV2Create,
+		Read:   resourceMembersV2Read,
+		Update: resourceMembersV2Update,
+		Delete: resourceMembersV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"pool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceMembersV2MemberHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol_port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"weight": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validatePositiveInt,
+						},
+
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"admin_state_up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMembersV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	members := resourceMembersV2Opts(d)
+
+	log.Printf("[DEBUG] Create Options: %#v", members)
+	if err := resourceMembersV2Set(networkingClient, poolID, members, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error setting the members of OpenStack LBaaSV2 pool %s: %s", poolID, err)
+	}
+
+	d.SetId(poolID)
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	members, err := lbMembersV2List(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "LBV2 members")
+	}
+
+	log.Printf("[DEBUG] Retrieved the members of OpenStack LBaaSV2 pool %s: %+v", d.Id(), members)
+
+	memberList := make([]map[string]interface{}, len(members))
+	for i, member := range members {
+		memberList[i] = map[string]interface{}{
+			"id":             member.ID,
+			"name":           member.Name,
+			"address":        member.Address,
+			"protocol_port":  member.ProtocolPort,
+			"weight":         member.Weight,
+			"subnet_id":      member.SubnetID,
+			"admin_state_up": member.AdminStateUp,
+		}
+	}
+
+	d.Set("pool_id", d.Id())
+	d.Set("member", memberList)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceMembersV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if d.HasChange("member") {
+		members := resourceMembersV2Opts(d)
+
+		log.Printf("[DEBUG] Updating the members of OpenStack LBaaSV2 pool %s with options: %+v", d.Id(), members)
+		if err := resourceMembersV2Set(networkingClient, d.Id(), members, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error updating the members of OpenStack LBaaSV2 pool %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := resourceMembersV2Set(networkingClient, d.Id(), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return CheckDeleted(d, err, "Error deleting the members of OpenStack LBaaSV2 pool")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceMembersV2Opts(d *schema.ResourceData) []LBMemberBatchOpts {
+	rawMembers := d.Get("member").(*schema.Set).List()
+
+	members := make([]LBMemberBatchOpts, len(rawMembers))
+	for i, raw := range rawMembers {
+		rawMap := raw.(map[string]interface{})
+		adminStateUp := rawMap["admin_state_up"].(bool)
+		members[i] = LBMemberBatchOpts{
+			Name:         rawMap["name"].(string),
+			Address:      rawMap["address"].(string),
+			ProtocolPort: rawMap["protocol_port"].(int),
+			Weight:       rawMap["weight"].(int),
+			SubnetID:     rawMap["subnet_id"].(string),
+			AdminStateUp: &adminStateUp,
+		}
+	}
+
+	return members
+}
+
+// resourceMembersV2Set replaces the members of a pool and waits for its load
+// balancer to apply the change. The load balancer is immutable while it
+// does, so the update is retried as long as it is busy.
+func resourceMembersV2Set(networkingClient *gophercloud.ServiceClient, poolID string, members []LBMemberBatchOpts, timeout time.Duration) error {
+	pool, err := pools.Get(networkingClient, poolID).Extract()
+	if err != nil {
+		return err
+	}
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err := lbMembersV2BatchUpdate(networkingClient, poolID, members)
+		if errCode, ok := err.(gophercloud.ErrUnexpectedResponseCode); ok && errCode.Actual == 409 {
+			log.Printf("[DEBUG] OpenStack LBaaSV2 pool %s is still being updated.", poolID)
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, lb := range pool.Loadbalancers {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"PENDING_CREATE", "PENDING_UPDATE"},
+			Target:     []string{"ACTIVE"},
+			Refresh:    waitForLoadBalancerActive(networkingClient, lb.ID),
+			Timeout:    timeout,
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMembersV2MemberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["protocol_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["weight"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["subnet_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t", m["admin_state_up"].(bool)))
+
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2Members_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MembersDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2MembersConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "member.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2MembersConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_lb_members_v2.members_1", "member.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2MembersDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_members_v2" {
+			continue
+		}
+
+		members, err := lbMembersV2List(networkingClient, rs.Primary.ID)
+		if err == nil && len(members) > 0 {
+			return fmt.Errorf("Pool %s still has %d members", rs.Primary.ID, len(members))
+		}
+	}
+
+	return nil
+}
+
+const testAccLBV2MembersConfig_pool = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+`
+
+var TestAccLBV2MembersConfig_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_members_v2" "members_1" {
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+
+  member {
+    address = "192.168.199.10"
+    protocol_port = 8080
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  member {
+    address = "192.168.199.11"
+    protocol_port = 8080
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+`, testAccLBV2MembersConfig_pool)
+
+var TestAccLBV2MembersConfig_update = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_members_v2" "members_1" {
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+
+  member {
+    address = "192.168.199.10"
+    protocol_port = 8080
+    weight = 10
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  member {
+    address = "192.168.199.12"
+    protocol_port = 8080
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  member {
+    address = "192.168.199.13"
+    protocol_port = 8080
+    admin_state_up = false
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+`, testAccLBV2MembersConfig_pool)
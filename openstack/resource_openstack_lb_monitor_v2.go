@@ -3,6 +3,8 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -56,16 +58,25 @@ func resourceMonitorV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"delay": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validatePositiveInt,
 			},
 			"timeout": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validatePositiveInt,
 			},
 			"max_retries": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: resourceMonitorV2ValidateMaxRetries,
+			},
+			"max_retries_down": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceMonitorV2ValidateMaxRetries,
 			},
 			"url_path": &schema.Schema{
 				Type:     schema.TypeString,
@@ -78,9 +89,20 @@ func resourceMonitorV2() *schema.Resource {
 				Computed: true,
 			},
 			"expected_codes": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceMonitorV2ValidateExpectedCodes,
+			},
+			"http_version": &schema.Schema{
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceMonitorV2ValidateHTTPVersion,
+			},
+			"domain_name": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Computed: true,
 			},
 			"admin_state_up": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -88,6 +110,19 @@ func resourceMonitorV2() *schema.Resource {
 				Optional: true,
 			},
 
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -104,24 +139,37 @@ func resourceMonitorV2Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	if err := resourceMonitorV2CheckTimeout(d); err != nil {
+		return err
+	}
+
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := monitors.CreateOpts{
-		PoolID:        d.Get("pool_id").(string),
-		TenantID:      d.Get("tenant_id").(string),
-		Type:          d.Get("type").(string),
-		Delay:         d.Get("delay").(int),
-		Timeout:       d.Get("timeout").(int),
-		MaxRetries:    d.Get("max_retries").(int),
-		URLPath:       d.Get("url_path").(string),
-		HTTPMethod:    d.Get("http_method").(string),
-		ExpectedCodes: d.Get("expected_codes").(string),
-		Name:          d.Get("name").(string),
-		AdminStateUp:  &adminStateUp,
+	createOpts := MonitorCreateOpts{
+		monitors.CreateOpts{
+			PoolID:        d.Get("pool_id").(string),
+			TenantID:      d.Get("tenant_id").(string),
+			Type:          d.Get("type").(string),
+			Delay:         d.Get("delay").(int),
+			Timeout:       d.Get("timeout").(int),
+			MaxRetries:    d.Get("max_retries").(int),
+			URLPath:       d.Get("url_path").(string),
+			HTTPMethod:    d.Get("http_method").(string),
+			ExpectedCodes: d.Get("expected_codes").(string),
+			Name:          d.Get("name").(string),
+			AdminStateUp:  &adminStateUp,
+		},
+		d.Get("max_retries_down").(int),
+		d.Get("http_version").(float64),
+		d.Get("domain_name").(string),
+		resourceLBV2TagsWithDefaults(d, config),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	monitor, err := monitors.Create(networkingClient, createOpts).Extract()
 	if err != nil {
+		if lbQuotaV2IsExceeded(err) {
+			err = lbQuotaV2ExceededError(d, config, networkingClient, "health_monitor", err)
+		}
 		return fmt.Errorf("Error creating OpenStack LBaaSV2 monitor: %s", err)
 	}
 	log.Printf("[INFO] monitor ID: %s", monitor.ID)
@@ -154,10 +202,14 @@ func resourceMonitorV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	monitor, err := monitors.Get(networkingClient, d.Id()).Extract()
+	var result struct {
+		Monitor Monitor `json:"healthmonitor"`
+	}
+	err = monitors.Get(networkingClient, d.Id()).ExtractInto(&result)
 	if err != nil {
 		return CheckDeleted(d, err, "LBV2 Monitor")
 	}
+	monitor := result.Monitor
 
 	log.Printf("[DEBUG] Retrieved OpenStack LBaaSV2 Monitor %s: %+v", d.Id(), monitor)
 
@@ -167,9 +219,13 @@ func resourceMonitorV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("delay", monitor.Delay)
 	d.Set("timeout", monitor.Timeout)
 	d.Set("max_retries", monitor.MaxRetries)
+	d.Set("max_retries_down", monitor.MaxRetriesDown)
 	d.Set("url_path", monitor.URLPath)
 	d.Set("http_method", monitor.HTTPMethod)
 	d.Set("expected_codes", monitor.ExpectedCodes)
+	d.Set("http_version", monitor.HTTPVersion)
+	d.Set("domain_name", monitor.DomainName)
+	d.Set("tags", resourceLBV2ReadTags(d, config, monitor.Tags))
 	d.Set("admin_state_up", monitor.AdminStateUp)
 	d.Set("name", monitor.Name)
 
@@ -183,7 +239,11 @@ func resourceMonitorV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts monitors.UpdateOpts
+	if err := resourceMonitorV2CheckTimeout(d); err != nil {
+		return err
+	}
+
+	var updateOpts MonitorUpdateOpts
 	if d.HasChange("url_path") {
 		updateOpts.URLPath = d.Get("url_path").(string)
 	}
@@ -199,16 +259,31 @@ func resourceMonitorV2Update(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("max_retries") {
 		updateOpts.MaxRetries = d.Get("max_retries").(int)
 	}
+	if d.HasChange("max_retries_down") {
+		updateOpts.MaxRetriesDown = d.Get("max_retries_down").(int)
+	}
 	if d.HasChange("admin_state_up") {
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
 	if d.HasChange("name") {
-		updateOpts.Name = d.Get("name").(string)
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
 	}
 	if d.HasChange("http_method") {
 		updateOpts.HTTPMethod = d.Get("http_method").(string)
 	}
+	if d.HasChange("http_version") {
+		updateOpts.HTTPVersion = d.Get("http_version").(float64)
+	}
+	if d.HasChange("domain_name") {
+		domainName := d.Get("domain_name").(string)
+		updateOpts.DomainName = &domainName
+	}
+	if d.HasChange("tags") || d.HasChange("ignore_default_tags") {
+		tags := resourceLBV2TagsWithDefaults(d, config)
+		updateOpts.Tags = &tags
+	}
 
 	log.Printf("[DEBUG] Updating OpenStack LBaaSV2 Monitor %s with options: %+v", d.Id(), updateOpts)
 
@@ -245,6 +320,72 @@ func resourceMonitorV2Delete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// resourceMonitorV2ValidateExpectedCodes ensures expected_codes is a single
+// HTTP status code, a range such as "200-204", or a comma separated list of
+// either.
+func resourceMonitorV2ValidateExpectedCodes(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	for _, code := range strings.Split(value, ",") {
+		bounds := strings.Split(code, "-")
+		if len(bounds) > 2 {
+			errors = append(errors, fmt.Errorf("%s contains an invalid range: %q", k, code))
+			continue
+		}
+
+		var prev int
+		for i, bound := range bounds {
+			c, err := strconv.Atoi(bound)
+			if err != nil || c < 100 || c > 599 {
+				errors = append(errors, fmt.Errorf("%s contains an invalid HTTP status code: %q", k, bound))
+				break
+			}
+
+			if i == 1 && c < prev {
+				errors = append(errors, fmt.Errorf("%s contains a range which ends before it starts: %q", k, code))
+			}
+			prev = c
+		}
+	}
+
+	return
+}
+
+func resourceMonitorV2ValidateHTTPVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(float64)
+	if value != 1.0 && value != 1.1 {
+		errors = append(errors, fmt.Errorf("%s must be either 1.0 or 1.1", k))
+	}
+
+	return
+}
+
+func resourceMonitorV2ValidateMaxRetries(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 1 || value > 10 {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and 10", k))
+	}
+
+	return
+}
+
+// resourceMonitorV2CheckTimeout ensures the timeout of a monitor isn't
+// greater than its delay, which the API would only reject with a generic
+// bad request error. Both values are needed, so this can't be done by a
+// ValidateFunc and is checked before any request is made instead.
+func resourceMonitorV2CheckTimeout(d *schema.ResourceData) error {
+	delay := d.Get("delay").(int)
+	timeout := d.Get("timeout").(int)
+	if timeout > delay {
+		return fmt.Errorf("The timeout (%d) of an OpenStack LBaaSV2 monitor can't be greater than its delay (%d)", timeout, delay)
+	}
+
+	return nil
+}
+
 func waitForMonitorActive(networkingClient *gophercloud.ServiceClient, monitorID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		monitor, err := monitors.Get(networkingClient, monitorID).Extract()
@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
@@ -36,6 +37,41 @@ func TestAccLBV2Monitor_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Monitor_http(t *testing.T) {
+	var monitor monitors.Monitor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MonitorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2MonitorConfig_http,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MonitorExists(t, "openstack_lb_monitor_v2.monitor_1", &monitor),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "expected_codes", "200-204"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "http_version", "1.1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "domain_name", "www.example.com"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2MonitorConfig_http_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "expected_codes", "200,202"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "http_version", "1.1"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "domain_name", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLBV2Monitor_timeout(t *testing.T) {
 	var monitor monitors.Monitor
 
@@ -54,6 +90,66 @@ func TestAccLBV2Monitor_timeout(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Monitor_maxRetriesDown(t *testing.T) {
+	var monitor monitors.Monitor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MonitorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2MonitorConfig_maxRetriesDown(20, 10, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MonitorExists(t, "openstack_lb_monitor_v2.monitor_1", &monitor),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "max_retries_down", "5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2MonitorConfig_maxRetriesDown(20, 20, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "timeout", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_monitor_v2.monitor_1", "max_retries_down", "2"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccLBV2MonitorConfig_maxRetriesDown(20, 30, 2),
+				ExpectError: regexp.MustCompile("can't be greater than its delay"),
+			},
+		},
+	})
+}
+
+func TestResourceMonitorV2ValidateExpectedCodes(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{value: "", valid: true},
+		{value: "200", valid: true},
+		{value: "200-204", valid: true},
+		{value: "200,202", valid: true},
+		{value: "200-204,301,500-599", valid: true},
+		{value: "99", valid: false},
+		{value: "600", valid: false},
+		{value: "abc", valid: false},
+		{value: "204-200", valid: false},
+		{value: "200-202-204", valid: false},
+		{value: "200-", valid: false},
+		{value: "200,,202", valid: false},
+	}
+
+	for _, c := range cases {
+		_, errs := resourceMonitorV2ValidateExpectedCodes(c.value, "expected_codes")
+		if valid := len(errs) == 0; valid != c.valid {
+			t.Errorf("expected_codes %q: expected valid to be %t, got errors: %v", c.value, c.valid, errs)
+		}
+	}
+}
+
 func testAccCheckLBV2MonitorDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -192,6 +288,97 @@ resource "openstack_lb_monitor_v2" "monitor_1" {
 }
 `
 
+const TestAccLBV2MonitorConfig_http = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "HTTP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  url_path = "/health"
+  expected_codes = "200-204"
+  http_version = 1.1
+  domain_name = "www.example.com"
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+`
+
+const TestAccLBV2MonitorConfig_http_update = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "HTTP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  url_path = "/health"
+  expected_codes = "200,202"
+  http_version = 1.1
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+`
+
 const TestAccLBV2MonitorConfig_timeout = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
@@ -238,3 +425,48 @@ resource "openstack_lb_monitor_v2" "monitor_1" {
   }
 }
 `
+
+func testAccLBV2MonitorConfig_maxRetriesDown(delay, timeout, maxRetriesDown int) string {
+	return fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "PING"
+  delay = %d
+  timeout = %d
+  max_retries = 5
+  max_retries_down = %d
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+`, delay, timeout, maxRetriesDown)
+}
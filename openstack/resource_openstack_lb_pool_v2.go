@@ -125,6 +125,68 @@ func resourcePoolV2() *schema.Resource {
 				Optional: true,
 			},
 
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tls_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tls_container_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ca_tls_container_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"crl_container_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tls_ciphers": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tls_versions": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceLBV2ValidateTLSVersion,
+				},
+				Set: schema.HashString,
+			},
+
+			"alpn_protocols": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: resourceLBV2ValidateALPNProtocol,
+				},
+				Set: schema.HashString,
+			},
+
 			"id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
@@ -151,15 +213,25 @@ func resourcePoolV2Create(d *schema.ResourceData, meta interface{}) error {
 			CookieName: pV["cookie_name"].(string),
 		}
 	}
-	createOpts := pools.CreateOpts{
-		TenantID:       d.Get("tenant_id").(string),
-		Name:           d.Get("name").(string),
-		Description:    d.Get("description").(string),
-		Protocol:       pools.Protocol(d.Get("protocol").(string)),
-		LoadbalancerID: d.Get("loadbalancer_id").(string),
-		ListenerID:     d.Get("listener_id").(string),
-		LBMethod:       pools.LBMethod(d.Get("lb_method").(string)),
-		AdminStateUp:   &adminStateUp,
+	createOpts := PoolCreateOpts{
+		CreateOpts: pools.CreateOpts{
+			TenantID:       d.Get("tenant_id").(string),
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			Protocol:       pools.Protocol(d.Get("protocol").(string)),
+			LoadbalancerID: d.Get("loadbalancer_id").(string),
+			ListenerID:     d.Get("listener_id").(string),
+			LBMethod:       pools.LBMethod(d.Get("lb_method").(string)),
+			AdminStateUp:   &adminStateUp,
+		},
+		Tags:              resourceLBV2TagsWithDefaults(d, config),
+		TLSEnabled:        d.Get("tls_enabled").(bool),
+		TLSContainerRef:   d.Get("tls_container_ref").(string),
+		CATLSContainerRef: d.Get("ca_tls_container_ref").(string),
+		CRLContainerRef:   d.Get("crl_container_ref").(string),
+		TLSCiphers:        d.Get("tls_ciphers").(string),
+		TLSVersions:       resourceLBV2StringSet(d, "tls_versions"),
+		ALPNProtocols:     resourceLBV2StringSet(d, "alpn_protocols"),
 	}
 	// Must omit if not set
 	if persistence != (pools.SessionPersistence{}) {
@@ -179,10 +251,14 @@ func resourcePoolV2Create(d *schema.ResourceData, meta interface{}) error {
 				log.Printf("[DEBUG] OpenStack LBaaSV2 pool is still creating.")
 				return resource.RetryableError(err)
 			case gophercloud.ErrUnexpectedResponseCode:
+				if lbQuotaV2IsExceeded(err) {
+					return resource.NonRetryableError(lbQuotaV2ExceededError(d, config, networkingClient, "pool", err))
+				}
 				if errCode.Actual == 409 {
 					log.Printf("[DEBUG] OpenStack LBaaSV2 pool is still creating.")
 					return resource.RetryableError(err)
 				}
+				return resource.NonRetryableError(err)
 			default:
 				return resource.NonRetryableError(err)
 			}
@@ -224,10 +300,14 @@ func resourcePoolV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	pool, err := pools.Get(networkingClient, d.Id()).Extract()
+	var result struct {
+		Pool Pool `json:"pool"`
+	}
+	err = pools.Get(networkingClient, d.Id()).ExtractInto(&result)
 	if err != nil {
 		return CheckDeleted(d, err, "LBV2 Pool")
 	}
+	pool := result.Pool
 
 	log.Printf("[DEBUG] Retrieved OpenStack LBaaSV2 Pool %s: %+v", d.Id(), pool)
 
@@ -239,6 +319,14 @@ func resourcePoolV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", pool.Name)
 	d.Set("id", pool.ID)
 	d.Set("persistence", pool.Persistence)
+	d.Set("tags", resourceLBV2ReadTags(d, config, pool.Tags))
+	d.Set("tls_enabled", pool.TLSEnabled)
+	d.Set("tls_container_ref", pool.TLSContainerRef)
+	d.Set("ca_tls_container_ref", pool.CATLSContainerRef)
+	d.Set("crl_container_ref", pool.CRLContainerRef)
+	d.Set("tls_ciphers", pool.TLSCiphers)
+	d.Set("tls_versions", pool.TLSVersions)
+	d.Set("alpn_protocols", pool.ALPNProtocols)
 
 	return nil
 }
@@ -250,7 +338,7 @@ func resourcePoolV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts pools.UpdateOpts
+	var updateOpts PoolUpdateOpts
 	if d.HasChange("lb_method") {
 		updateOpts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
 	}
@@ -264,6 +352,38 @@ func resourcePoolV2Update(d *schema.ResourceData, meta interface{}) error {
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
+	if d.HasChange("tags") || d.HasChange("ignore_default_tags") {
+		tags := resourceLBV2TagsWithDefaults(d, config)
+		updateOpts.Tags = &tags
+	}
+	if d.HasChange("tls_enabled") {
+		tlsEnabled := d.Get("tls_enabled").(bool)
+		updateOpts.TLSEnabled = &tlsEnabled
+	}
+	if d.HasChange("tls_container_ref") {
+		tlsContainerRef := d.Get("tls_container_ref").(string)
+		updateOpts.TLSContainerRef = &tlsContainerRef
+	}
+	if d.HasChange("ca_tls_container_ref") {
+		caTLSContainerRef := d.Get("ca_tls_container_ref").(string)
+		updateOpts.CATLSContainerRef = &caTLSContainerRef
+	}
+	if d.HasChange("crl_container_ref") {
+		crlContainerRef := d.Get("crl_container_ref").(string)
+		updateOpts.CRLContainerRef = &crlContainerRef
+	}
+	if d.HasChange("tls_ciphers") {
+		tlsCiphers := d.Get("tls_ciphers").(string)
+		updateOpts.TLSCiphers = &tlsCiphers
+	}
+	if d.HasChange("tls_versions") {
+		tlsVersions := resourceLBV2StringSet(d, "tls_versions")
+		updateOpts.TLSVersions = &tlsVersions
+	}
+	if d.HasChange("alpn_protocols") {
+		alpnProtocols := resourceLBV2StringSet(d, "alpn_protocols")
+		updateOpts.ALPNProtocols = &alpnProtocols
+	}
 
 	log.Printf("[DEBUG] Updating OpenStack LBaaSV2 Pool %s with options: %+v", d.Id(), updateOpts)
 
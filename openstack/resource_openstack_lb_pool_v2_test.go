@@ -51,6 +51,26 @@ func TestAccLBV2Pool_timeout(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Pool_tls(t *testing.T) {
+	var pool pools.Pool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2PoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2PoolConfig_tls,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2PoolExists("openstack_lb_pool_v2.pool_1", &pool),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_enabled", "true"),
+					resource.TestCheckResourceAttr("openstack_lb_pool_v2.pool_1", "tls_versions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2PoolDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -208,3 +228,39 @@ resource "openstack_lb_pool_v2" "pool_1" {
   }
 }
 `
+
+const TestAccLBV2PoolConfig_tls = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+  tls_enabled = true
+  tls_versions = ["TLSv1.2"]
+  tls_ciphers = "ECDHE-RSA-AES256-GCM-SHA384"
+}
+`
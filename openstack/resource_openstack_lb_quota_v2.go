@@ -0,0 +1,176 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLBQuotaV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLBQuotaV2Create,
+		Read:   resourceLBQuotaV2Read,
+		Update: resourceLBQuotaV2Update,
+		Delete: resourceLBQuotaV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"loadbalancer": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          -1,
+				DiffSuppressFunc: resourceLBQuotaV2SuppressUnset,
+			},
+
+			"listener": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          -1,
+				DiffSuppressFunc: resourceLBQuotaV2SuppressUnset,
+			},
+
+			"member": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          -1,
+				DiffSuppressFunc: resourceLBQuotaV2SuppressUnset,
+			},
+
+			"pool": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          -1,
+				DiffSuppressFunc: resourceLBQuotaV2SuppressUnset,
+			},
+
+			"health_monitor": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          -1,
+				DiffSuppressFunc: resourceLBQuotaV2SuppressUnset,
+			},
+		},
+	}
+}
+
+func resourceLBQuotaV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	updateOpts := resourceLBQuotaV2UpdateOpts(d)
+
+	log.Printf("[DEBUG] Create Options: %#v", updateOpts)
+	if err := lbQuotaV2Update(networkingClient, projectID, updateOpts); err != nil {
+		return fmt.Errorf("Error setting OpenStack LBaaSV2 quota of project %s: %s", projectID, err)
+	}
+
+	d.SetId(projectID)
+
+	return resourceLBQuotaV2Read(d, meta)
+}
+
+func resourceLBQuotaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	quota, err := lbQuotaV2Get(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "LBV2 quota")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack LBaaSV2 quota %s: %+v", d.Id(), quota)
+
+	d.Set("project_id", d.Id())
+	d.Set("loadbalancer", quota.LoadBalancer)
+	d.Set("listener", quota.Listener)
+	d.Set("member", quota.Member)
+	d.Set("pool", quota.Pool)
+	d.Set("health_monitor", quota.HealthMonitor)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceLBQuotaV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	updateOpts := resourceLBQuotaV2UpdateOpts(d)
+
+	log.Printf("[DEBUG] Updating OpenStack LBaaSV2 quota %s with options: %+v", d.Id(), updateOpts)
+
+	if err := lbQuotaV2Update(networkingClient, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack LBaaSV2 quota: %s", err)
+	}
+
+	return resourceLBQuotaV2Read(d, meta)
+}
+
+func resourceLBQuotaV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := lbQuotaV2Delete(networkingClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "LBV2 quota")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceLBQuotaV2UpdateOpts sends every quota which is set. A quota of -1
+// is not set and keeps its current value, which is the cloud's default for
+// a new quota.
+func resourceLBQuotaV2UpdateOpts(d *schema.ResourceData) LBQuotaUpdateOpts {
+	quota := func(key string) *int {
+		v := d.Get(key).(int)
+		if v == -1 {
+			return nil
+		}
+		return &v
+	}
+
+	return LBQuotaUpdateOpts{
+		LoadBalancer:  quota("loadbalancer"),
+		Listener:      quota("listener"),
+		Member:        quota("member"),
+		Pool:          quota("pool"),
+		HealthMonitor: quota("health_monitor"),
+	}
+}
+
+// resourceLBQuotaV2SuppressUnset ignores the quotas which are not set once
+// the quota exists, so that they show the value the cloud uses.
+func resourceLBQuotaV2SuppressUnset(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && new == "-1"
+}
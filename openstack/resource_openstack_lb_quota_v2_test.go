@@ -0,0 +1,92 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2Quota_basic(t *testing.T) {
+	var quota LBQuota
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2QuotaConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2QuotaExists("openstack_lb_quota_v2.quota_1", &quota),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "loadbalancer", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "pool", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "member", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2QuotaConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "loadbalancer", "15"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_quota_v2.quota_1", "health_monitor", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2QuotaExists(n string, quota *LBQuota) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := lbQuotaV2Get(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*quota = *found
+
+		return nil
+	}
+}
+
+var testAccLBV2QuotaConfig_basic = fmt.Sprintf(`
+resource "openstack_lb_quota_v2" "quota_1" {
+  project_id   = "%s"
+  loadbalancer = 10
+  pool         = 20
+  member       = 0
+}
+`, os.Getenv("OS_TENANT_ID"))
+
+var testAccLBV2QuotaConfig_update = fmt.Sprintf(`
+resource "openstack_lb_quota_v2" "quota_1" {
+  project_id     = "%s"
+  loadbalancer   = 15
+  pool           = 20
+  member         = 0
+  health_monitor = 5
+}
+`, os.Getenv("OS_TENANT_ID"))
@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingAutoAllocatedTopologyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingAutoAllocatedTopologyV2Create,
+		Read:   resourceNetworkingAutoAllocatedTopologyV2Read,
+		Delete: resourceNetworkingAutoAllocatedTopologyV2Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnet_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"router_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingAutoAllocatedTopologyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		projectID = identityV3TokenProjectID(config, GetRegion(d))
+		if projectID == "" {
+			return fmt.Errorf("Unable to determine the project of the auto-allocated topology, please set project_id")
+		}
+	}
+
+	log.Printf("[DEBUG] Allocating topology of project %s", projectID)
+	topology, err := networkingAutoAllocatedTopologyV2Get(networkingClient, projectID)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack auto-allocated topology of project %s: %s", projectID, err)
+	}
+
+	log.Printf("[DEBUG] Allocated network %s to project %s", topology.ID, projectID)
+
+	d.SetId(projectID)
+	d.Set("network_id", topology.ID)
+
+	return resourceNetworkingAutoAllocatedTopologyV2Read(d, meta)
+}
+
+func resourceNetworkingAutoAllocatedTopologyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	network, err := networks.Get(networkingClient, d.Get("network_id").(string)).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "auto-allocated topology")
+	}
+
+	log.Printf("[DEBUG] Retrieved auto-allocated network %s: %+v", network.ID, network)
+
+	// The router of the topology is the one with an interface on its
+	// network.
+	listOpts := ports.ListOpts{
+		NetworkID:   network.ID,
+		DeviceOwner: "network:router_interface",
+	}
+	allPages, err := ports.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to list router interfaces of network %s: %s", network.ID, err)
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve router interfaces of network %s: %s", network.ID, err)
+	}
+
+	var routerID string
+	if len(allPorts) > 0 {
+		routerID = allPorts[0].DeviceID
+	}
+
+	d.Set("project_id", d.Id())
+	d.Set("subnet_ids", network.Subnets)
+	d.Set("router_id", routerID)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceNetworkingAutoAllocatedTopologyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting auto-allocated topology of project %s", d.Id())
+	if err := networkingAutoAllocatedTopologyV2Delete(networkingClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "auto-allocated topology")
+	}
+
+	d.SetId("")
+	return nil
+}
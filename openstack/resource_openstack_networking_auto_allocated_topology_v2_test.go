@@ -0,0 +1,90 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2AutoAllocatedTopology_basic(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2AutoAllocatedTopologyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2AutoAllocatedTopology_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2AutoAllocatedTopologyExists(
+						"openstack_networking_auto_allocated_topology_v2.topology_1", &network),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_auto_allocated_topology_v2.topology_1", "router_id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_auto_allocated_topology_v2.topology_1", "subnet_ids.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2AutoAllocatedTopologyExists(n string, network *networks.Network) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networks.Get(networkingClient, rs.Primary.Attributes["network_id"]).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.TenantID != rs.Primary.ID {
+			return fmt.Errorf("Network %s does not belong to project %s", found.ID, rs.Primary.ID)
+		}
+
+		*network = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2AutoAllocatedTopologyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_auto_allocated_topology_v2" {
+			continue
+		}
+
+		_, err := networks.Get(networkingClient, rs.Primary.Attributes["network_id"]).Extract()
+		if err == nil {
+			return fmt.Errorf("Auto-allocated network still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingV2AutoAllocatedTopology_basic = `
+resource "openstack_networking_auto_allocated_topology_v2" "topology_1" {}
+`
@@ -42,7 +42,8 @@ func resourceNetworkingFloatingIPV2() *schema.Resource {
 			},
 			"pool": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_POOL_NAME", nil),
 			},
@@ -62,11 +63,87 @@ func resourceNetworkingFloatingIPV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"all_tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dns_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"port_details": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_owner": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
 				ForceNew: true,
 			},
+			"prevent_destroy_if_associated": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -78,12 +155,21 @@ func resourceNetworkFloatingIPV2Create(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack network client: %s", err)
 	}
 
-	poolID, err := getNetworkID(d, meta, d.Get("pool").(string))
-	if err != nil {
-		return fmt.Errorf("Error retrieving floating IP pool name: %s", err)
-	}
-	if len(poolID) == 0 {
-		return fmt.Errorf("No network found with name: %s", d.Get("pool").(string))
+	var poolID string
+	if pool := d.Get("pool").(string); pool != "" {
+		poolID, err = getNetworkID(d, meta, pool)
+		if err != nil {
+			return fmt.Errorf("Error retrieving floating IP pool name: %s", err)
+		}
+		if len(poolID) == 0 {
+			return fmt.Errorf("No network found with name: %s", pool)
+		}
+	} else {
+		network, err := networkingNetworkV2External(networkingClient, config)
+		if err != nil {
+			return fmt.Errorf("Error selecting floating IP pool: %s", err)
+		}
+		poolID = network.ID
 	}
 	createOpts := FloatingIPCreateOpts{
 		floatingips.CreateOpts{
@@ -92,6 +178,10 @@ func resourceNetworkFloatingIPV2Create(d *schema.ResourceData, meta interface{})
 			TenantID:          d.Get("tenant_id").(string),
 			FixedIP:           d.Get("fixed_ip").(string),
 		},
+		d.Get("description").(string),
+		d.Get("qos_policy_id").(string),
+		d.Get("dns_name").(string),
+		d.Get("dns_domain").(string),
 		MapValueSpecs(d),
 	}
 
@@ -115,6 +205,10 @@ func resourceNetworkFloatingIPV2Create(d *schema.ResourceData, meta interface{})
 
 	d.SetId(floatingIP.ID)
 
+	if err := networkingV2SetTags(networkingClient, config, d, "floatingips"); err != nil {
+		return err
+	}
+
 	return resourceNetworkFloatingIPV2Read(d, meta)
 }
 
@@ -125,12 +219,56 @@ func resourceNetworkFloatingIPV2Read(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OpenStack network client: %s", err)
 	}
 
-	floatingIP, err := floatingips.Get(networkingClient, d.Id()).Extract()
+	r := floatingips.Get(networkingClient, d.Id())
+	floatingIP, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "floating IP")
 	}
 
+	var floatingIPWithExtensions struct {
+		FloatingIP struct {
+			Description string   `json:"description"`
+			Tags        []string `json:"tags"`
+			QoSPolicyID string   `json:"qos_policy_id"`
+			DNSName     string   `json:"dns_name"`
+			DNSDomain   string   `json:"dns_domain"`
+			PortDetails *struct {
+				Name         string `json:"name"`
+				NetworkID    string `json:"network_id"`
+				MACAddress   string `json:"mac_address"`
+				AdminStateUp bool   `json:"admin_state_up"`
+				Status       string `json:"status"`
+				DeviceID     string `json:"device_id"`
+				DeviceOwner  string `json:"device_owner"`
+			} `json:"port_details"`
+		} `json:"floatingip"`
+	}
+	if err := r.ExtractInto(&floatingIPWithExtensions); err != nil {
+		return fmt.Errorf("Error extracting extensions of floating IP %s: %s", d.Id(), err)
+	}
+
 	d.Set("address", floatingIP.FloatingIP)
+	d.Set("description", floatingIPWithExtensions.FloatingIP.Description)
+	networkingV2ReadAttributesTags(d, config, floatingIPWithExtensions.FloatingIP.Tags)
+	d.Set("qos_policy_id", floatingIPWithExtensions.FloatingIP.QoSPolicyID)
+	d.Set("dns_name", floatingIPWithExtensions.FloatingIP.DNSName)
+	d.Set("dns_domain", floatingIPWithExtensions.FloatingIP.DNSDomain)
+
+	// port_details is only returned by the fip-port-details extension, and
+	// only while the floating IP is associated.
+	var portDetails []map[string]interface{}
+	if p := floatingIPWithExtensions.FloatingIP.PortDetails; p != nil {
+		portDetails = append(portDetails, map[string]interface{}{
+			"name":           p.Name,
+			"network_id":     p.NetworkID,
+			"mac_address":    p.MACAddress,
+			"admin_state_up": p.AdminStateUp,
+			"status":         p.Status,
+			"device_id":      p.DeviceID,
+			"device_owner":   p.DeviceOwner,
+		})
+	}
+	d.Set("port_details", portDetails)
 	d.Set("port_id", floatingIP.PortID)
 	d.Set("fixed_ip", floatingIP.FixedIP)
 	poolName, err := getNetworkName(d, meta, floatingIP.FloatingNetworkID)
@@ -152,18 +290,42 @@ func resourceNetworkFloatingIPV2Update(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack network client: %s", err)
 	}
 
-	var updateOpts floatingips.UpdateOpts
+	// Only send the attributes which changed: floatingips.UpdateOpts always
+	// sends port_id, and a null port_id disassociates the floating IP.
+	updateOpts := make(map[string]interface{})
 
 	if d.HasChange("port_id") {
-		portID := d.Get("port_id").(string)
-		updateOpts.PortID = &portID
+		var portID interface{}
+		if v := d.Get("port_id").(string); v != "" {
+			portID = v
+		}
+		updateOpts["port_id"] = portID
 	}
 
-	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	if d.HasChange("description") {
+		updateOpts["description"] = d.Get("description").(string)
+	}
 
-	_, err = floatingips.Update(networkingClient, d.Id(), updateOpts).Extract()
-	if err != nil {
-		return fmt.Errorf("Error updating floating IP: %s", err)
+	if d.HasChange("qos_policy_id") {
+		var qosPolicyID interface{}
+		if v := d.Get("qos_policy_id").(string); v != "" {
+			qosPolicyID = v
+		}
+		updateOpts["qos_policy_id"] = qosPolicyID
+	}
+
+	if len(updateOpts) > 0 {
+		log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
+		b := map[string]interface{}{"floatingip": updateOpts}
+		url := networkingClient.ServiceURL("floatingips", d.Id())
+		if err := networkingV2Update(networkingClient, url, b, 0); err != nil {
+			return fmt.Errorf("Error updating floating IP: %s", err)
+		}
+	}
+
+	if err := networkingV2SetTags(networkingClient, config, d, "floatingips"); err != nil {
+		return err
 	}
 
 	return resourceNetworkFloatingIPV2Read(d, meta)
@@ -176,6 +338,18 @@ func resourceNetworkFloatingIPV2Delete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack network client: %s", err)
 	}
 
+	if d.Get("prevent_destroy_if_associated").(bool) {
+		floatingIP, err := floatingips.Get(networkingClient, d.Id()).Extract()
+		if err != nil {
+			return CheckDeleted(d, err, "floating IP")
+		}
+
+		if floatingIP.PortID != "" {
+			return fmt.Errorf("Floating IP %s is still associated with port %s. Disassociate it "+
+				"or unset prevent_destroy_if_associated to delete it.", floatingIP.FloatingIP, floatingIP.PortID)
+		}
+	}
+
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"ACTIVE"},
 		Target:     []string{"DELETED"},
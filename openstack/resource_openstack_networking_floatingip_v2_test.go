@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -29,6 +30,64 @@ func TestAccNetworkingV2FloatingIP_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2FloatingIP_dns(t *testing.T) {
+	var fip floatingips.FloatingIP
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2FloatingIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2FloatingIP_dns,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "dns_name", "fip-1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "dns_domain", "example.com."),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2FloatingIP_updateInPlace(t *testing.T) {
+	var fip1, fip2 floatingips.FloatingIP
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2FloatingIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2FloatingIP_updateInPlace_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip1),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "description", "floating IP 1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "tags.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2FloatingIP_updateInPlace_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip2),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "description", "floating IP 1 updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "tags.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_floatingip_v2.fip_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+					testAccCheckNetworkingV2FloatingIPSame(&fip1, &fip2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2FloatingIP_attach(t *testing.T) {
 	var instance servers.Server
 	var fip floatingips.FloatingIP
@@ -63,6 +122,41 @@ func TestAccNetworkingV2FloatingIP_fixedip_bind(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip),
 					testAccCheckNetworkingV2FloatingIPBoundToCorrectIP(&fip, "192.168.199.20"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "port_details.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_floatingip_v2.fip_1", "port_details.0.network_id",
+						"openstack_networking_network_v2.network_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2FloatingIP_preventDestroyIfAssociated(t *testing.T) {
+	var fip floatingips.FloatingIP
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2FloatingIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2FloatingIP_preventDestroyIfAssociated_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists("openstack_networking_floatingip_v2.fip_1", &fip),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccNetworkingV2FloatingIP_preventDestroyIfAssociated_1,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("still associated with port"),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2FloatingIP_preventDestroyIfAssociated_2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_v2.fip_1", "prevent_destroy_if_associated", "false"),
 				),
 			},
 		},
@@ -172,11 +266,53 @@ func testAccCheckNetworkingV2InstanceFloatingIPAttach(
 	}
 }
 
+func testAccCheckNetworkingV2FloatingIPSame(fip1, fip2 *floatingips.FloatingIP) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if fip1.ID != fip2.ID || fip1.FloatingIP != fip2.FloatingIP {
+			return fmt.Errorf("Floating IP %s (%s) was replaced by %s (%s)", fip1.ID, fip1.FloatingIP, fip2.ID, fip2.FloatingIP)
+		}
+		if fip1.PortID != fip2.PortID {
+			return fmt.Errorf("Floating IP %s was moved from port %q to %q", fip1.ID, fip1.PortID, fip2.PortID)
+		}
+		return nil
+	}
+}
+
 const testAccNetworkingV2FloatingIP_basic = `
 resource "openstack_networking_floatingip_v2" "fip_1" {
 }
 `
 
+const testAccNetworkingV2FloatingIP_dns = `
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  dns_name = "fip-1"
+  dns_domain = "example.com."
+}
+`
+
+const testAccNetworkingV2FloatingIP_updateInPlace_1 = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  description = "floating IP 1"
+  tags = ["foo"]
+}
+`
+
+const testAccNetworkingV2FloatingIP_updateInPlace_2 = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  description = "floating IP 1 updated"
+  tags = ["foo", "bar"]
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+}
+`
+
 var testAccNetworkV2FloatingIP_attach = fmt.Sprintf(`
 resource "openstack_networking_floatingip_v2" "fip_1" {
 }
@@ -237,6 +373,88 @@ resource "openstack_networking_floatingip_v2" "fip_1" {
 }
 `, OS_EXTGW_ID, OS_POOL_NAME)
 
+var testAccNetworkingV2FloatingIP_preventDestroyIfAssociated_1 = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  external_gateway = "%s"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  admin_state_up = "true"
+  network_id = "${openstack_networking_subnet_v2.subnet_1.network_id}"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+  port_id = "${openstack_networking_port_v2.port_1.id}"
+  prevent_destroy_if_associated = true
+
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+`, OS_EXTGW_ID, OS_POOL_NAME)
+
+var testAccNetworkingV2FloatingIP_preventDestroyIfAssociated_2 = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  external_gateway = "%s"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  admin_state_up = "true"
+  network_id = "${openstack_networking_subnet_v2.subnet_1.network_id}"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+  port_id = "${openstack_networking_port_v2.port_1.id}"
+  prevent_destroy_if_associated = false
+
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+`, OS_EXTGW_ID, OS_POOL_NAME)
+
 const testAccNetworkingV2FloatingIP_timeout = `
 resource "openstack_networking_floatingip_v2" "fip_1" {
   timeouts {
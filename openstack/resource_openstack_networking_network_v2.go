@@ -83,11 +83,52 @@ func resourceNetworkingNetworkV2() *schema.Resource {
 					},
 				},
 			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"all_tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
 				ForceNew: true,
 			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port_security_enabled": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePortSecurityEnabledV2,
+			},
+			"mtu": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePositiveInt,
+			},
+			"revision_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -104,6 +145,10 @@ func resourceNetworkingNetworkV2Create(d *schema.ResourceData, meta interface{})
 			Name:     d.Get("name").(string),
 			TenantID: d.Get("tenant_id").(string),
 		},
+		d.Get("qos_policy_id").(string),
+		d.Get("dns_domain").(string),
+		d.Get("mtu").(int),
+		resourcePortSecurityEnabledV2(d),
 		MapValueSpecs(d),
 	}
 
@@ -161,6 +206,10 @@ func resourceNetworkingNetworkV2Create(d *schema.ResourceData, meta interface{})
 
 	d.SetId(n.ID)
 
+	if err := networkingV2SetTags(networkingClient, config, d, "networks"); err != nil {
+		return err
+	}
+
 	return resourceNetworkingNetworkV2Read(d, meta)
 }
 
@@ -171,17 +220,40 @@ func resourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	n, err := networks.Get(networkingClient, d.Id()).Extract()
+	r := networks.Get(networkingClient, d.Id())
+	n, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "network")
 	}
 
 	log.Printf("[DEBUG] Retrieved Network %s: %+v", d.Id(), n)
 
+	var networkWithExtensions struct {
+		Network struct {
+			RevisionNumber int      `json:"revision_number"`
+			QoSPolicyID    string   `json:"qos_policy_id"`
+			Tags           []string `json:"tags"`
+			DNSDomain      string   `json:"dns_domain"`
+			MTU            int      `json:"mtu"`
+			PortSecurity   *bool    `json:"port_security_enabled"`
+		} `json:"network"`
+	}
+	if err := r.ExtractInto(&networkWithExtensions); err != nil {
+		return fmt.Errorf("Error extracting extensions of network %s: %s", d.Id(), err)
+	}
+
 	d.Set("name", n.Name)
 	d.Set("admin_state_up", strconv.FormatBool(n.AdminStateUp))
 	d.Set("shared", strconv.FormatBool(n.Shared))
 	d.Set("tenant_id", n.TenantID)
+	d.Set("qos_policy_id", networkWithExtensions.Network.QoSPolicyID)
+	d.Set("dns_domain", networkWithExtensions.Network.DNSDomain)
+	d.Set("mtu", networkWithExtensions.Network.MTU)
+	if portSecurity := networkWithExtensions.Network.PortSecurity; portSecurity != nil {
+		d.Set("port_security_enabled", strconv.FormatBool(*portSecurity))
+	}
+	d.Set("revision_number", networkWithExtensions.Network.RevisionNumber)
+	networkingV2ReadAttributesTags(d, config, networkWithExtensions.Network.Tags)
 	d.Set("region", GetRegion(d))
 
 	return nil
@@ -221,11 +293,37 @@ func resourceNetworkingNetworkV2Update(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Updating Network %s with options: %+v", d.Id(), updateOpts)
 
-	_, err = networks.Update(networkingClient, d.Id(), updateOpts).Extract()
+	b, err := updateOpts.ToNetworkUpdateMap()
+	if err != nil {
+		return fmt.Errorf("Error building update request for OpenStack Neutron Network: %s", err)
+	}
+
+	if d.HasChange("qos_policy_id") {
+		networkingQoSPolicyIDV2Update(d, b, "network")
+	}
+
+	if d.HasChange("dns_domain") {
+		b["network"].(map[string]interface{})["dns_domain"] = d.Get("dns_domain").(string)
+	}
+
+	if d.HasChange("mtu") {
+		b["network"].(map[string]interface{})["mtu"] = d.Get("mtu").(int)
+	}
+
+	if portSecurity := resourcePortSecurityEnabledV2(d); d.HasChange("port_security_enabled") && portSecurity != nil {
+		b["network"].(map[string]interface{})["port_security_enabled"] = *portSecurity
+	}
+
+	url := networkingClient.ServiceURL("networks", d.Id())
+	err = networkingV2Update(networkingClient, url, b, networkingV2RevisionNumber(d, config))
 	if err != nil {
 		return fmt.Errorf("Error updating OpenStack Neutron Network: %s", err)
 	}
 
+	if err := networkingV2SetTags(networkingClient, config, d, "networks"); err != nil {
+		return err
+	}
+
 	return resourceNetworkingNetworkV2Read(d, meta)
 }
 
@@ -40,6 +40,95 @@ func TestAccNetworkingV2Network_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Network_qosPolicy(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_qosPolicy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_network_v2.network_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_qosPolicyRemoved,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "qos_policy_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Network_mtu(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_mtu,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "mtu", "1400"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_mtuUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "mtu", "1300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Network_tags(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_tags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "tags.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "all_tags.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_tagsUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "tags.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "all_tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Network_netstack(t *testing.T) {
 	var network networks.Network
 	var subnet subnets.Subnet
@@ -193,6 +282,61 @@ resource "openstack_networking_network_v2" "network_1" {
 }
 `
 
+const testAccNetworkingV2Network_qosPolicy = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+}
+`
+
+const testAccNetworkingV2Network_qosPolicyRemoved = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+`
+
+const testAccNetworkingV2Network_mtu = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  mtu = 1400
+}
+`
+
+const testAccNetworkingV2Network_mtuUpdate = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  mtu = 1300
+}
+`
+
+const testAccNetworkingV2Network_tags = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  tags = ["foo", "bar"]
+}
+`
+
+const testAccNetworkingV2Network_tagsUpdate = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  tags = ["foo"]
+}
+`
+
 const testAccNetworkingV2Network_netstack = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
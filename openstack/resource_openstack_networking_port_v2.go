@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -57,6 +60,18 @@ func resourceNetworkingPortV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 				Computed: true,
+				// Neutron returns MAC addresses in lower case, and
+				// doesn't let the MAC address of a bound port be
+				// changed, so a new port is created instead.
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if _, err := net.ParseMAC(v.(string)); err != nil {
+						errors = append(errors, fmt.Errorf("%q must be a MAC address such as fa:16:3e:00:00:01: %s", k, err))
+					}
+					return
+				},
 			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -121,6 +136,23 @@ func resourceNetworkingPortV2() *schema.Resource {
 					},
 				},
 			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"all_tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -131,6 +163,29 @@ func resourceNetworkingPortV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"port_security_enabled": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePortSecurityEnabledV2,
+			},
+			"binding": resourceNetworkingPortBindingV2Schema(),
+			"revision_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -142,6 +197,11 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	bindingOpts, err := resourceNetworkingPortBindingV2Opts(d)
+	if err != nil {
+		return err
+	}
+
 	createOpts := PortCreateOpts{
 		ports.CreateOpts{
 			Name:                d.Get("name").(string),
@@ -155,6 +215,11 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 			FixedIPs:            resourcePortFixedIpsV2(d),
 			AllowedAddressPairs: resourceAllowedAddressPairsV2(d),
 		},
+		bindingOpts,
+		d.Get("qos_policy_id").(string),
+		d.Get("dns_name").(string),
+		d.Get("dns_domain").(string),
+		resourcePortSecurityEnabledV2(d),
 		MapValueSpecs(d),
 	}
 
@@ -179,6 +244,10 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(p.ID)
 
+	if err := networkingV2SetTags(networkingClient, config, d, "ports"); err != nil {
+		return err
+	}
+
 	return resourceNetworkingPortV2Read(d, meta)
 }
 
@@ -189,13 +258,29 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	p, err := ports.Get(networkingClient, d.Id()).Extract()
+	r := ports.Get(networkingClient, d.Id())
+	p, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "port")
 	}
 
 	log.Printf("[DEBUG] Retrieved Port %s: %+v", d.Id(), p)
 
+	var portWithExtensions struct {
+		Port struct {
+			RevisionNumber int      `json:"revision_number"`
+			QoSPolicyID    string   `json:"qos_policy_id"`
+			Tags           []string `json:"tags"`
+			DNSName        string   `json:"dns_name"`
+			DNSDomain      string   `json:"dns_domain"`
+			PortSecurity   *bool    `json:"port_security_enabled"`
+			NetworkingPortBinding
+		} `json:"port"`
+	}
+	if err := r.ExtractInto(&portWithExtensions); err != nil {
+		return fmt.Errorf("Error extracting extensions of port %s: %s", d.Id(), err)
+	}
+
 	d.Set("name", p.Name)
 	d.Set("admin_state_up", p.AdminStateUp)
 	d.Set("network_id", p.NetworkID)
@@ -204,6 +289,15 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 	d.Set("device_owner", p.DeviceOwner)
 	d.Set("security_group_ids", p.SecurityGroups)
 	d.Set("device_id", p.DeviceID)
+	d.Set("qos_policy_id", portWithExtensions.Port.QoSPolicyID)
+	d.Set("dns_name", portWithExtensions.Port.DNSName)
+	d.Set("dns_domain", portWithExtensions.Port.DNSDomain)
+	if portSecurity := portWithExtensions.Port.PortSecurity; portSecurity != nil {
+		d.Set("port_security_enabled", strconv.FormatBool(*portSecurity))
+	}
+	d.Set("binding", flattenNetworkingPortBindingV2(portWithExtensions.Port.NetworkingPortBinding))
+	d.Set("revision_number", portWithExtensions.Port.RevisionNumber)
+	networkingV2ReadAttributesTags(d, config, portWithExtensions.Port.Tags)
 
 	// Create a slice of all returned Fixed IPs.
 	// This will be in the order returned by the API,
@@ -245,6 +339,13 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 		SecurityGroups:      resourcePortSecurityGroupsV2(d),
 	}
 
+	// Neutron refuses to disable the port security of a port which has
+	// security groups, including the default one it was given on creation.
+	portSecurity := resourcePortSecurityEnabledV2(d)
+	if portSecurity != nil && !*portSecurity {
+		updateOpts.SecurityGroups = []string{}
+	}
+
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
@@ -267,11 +368,41 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Updating Port %s with options: %+v", d.Id(), updateOpts)
 
-	_, err = ports.Update(networkingClient, d.Id(), updateOpts).Extract()
+	b, err := updateOpts.ToPortUpdateMap()
+	if err != nil {
+		return fmt.Errorf("Error building update request for OpenStack Neutron Port: %s", err)
+	}
+
+	if d.HasChange("qos_policy_id") {
+		networkingQoSPolicyIDV2Update(d, b, "port")
+	}
+
+	for _, key := range []string{"dns_name", "dns_domain"} {
+		if d.HasChange(key) {
+			b["port"].(map[string]interface{})[key] = d.Get(key).(string)
+		}
+	}
+
+	if d.HasChange("port_security_enabled") && portSecurity != nil {
+		b["port"].(map[string]interface{})["port_security_enabled"] = *portSecurity
+	}
+
+	if d.HasChange("binding") {
+		if err := resourceNetworkingPortBindingV2Update(d, b); err != nil {
+			return err
+		}
+	}
+
+	url := networkingClient.ServiceURL("ports", d.Id())
+	err = networkingV2Update(networkingClient, url, b, networkingV2RevisionNumber(d, config))
 	if err != nil {
 		return fmt.Errorf("Error updating OpenStack Neutron Network: %s", err)
 	}
 
+	if err := networkingV2SetTags(networkingClient, config, d, "ports"); err != nil {
+		return err
+	}
+
 	return resourceNetworkingPortV2Read(d, meta)
 }
 
@@ -352,6 +483,26 @@ func resourcePortAdminStateUpV2(d *schema.ResourceData) *bool {
 	return &value
 }
 
+// resourcePortSecurityEnabledV2 returns the port_security_enabled of a port
+// or network, or nil when it isn't set so that it's inherited from the
+// network or the Neutron configuration.
+func resourcePortSecurityEnabledV2(d *schema.ResourceData) *bool {
+	raw := d.Get("port_security_enabled").(string)
+	if raw == "" {
+		return nil
+	}
+
+	value, _ := strconv.ParseBool(raw)
+	return &value
+}
+
+func validatePortSecurityEnabledV2(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := strconv.ParseBool(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q, if provided, must be either 'true' or 'false'", k))
+	}
+	return
+}
+
 func allowedAddressPairsHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -0,0 +1,154 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQoSBandwidthLimitRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSBandwidthLimitRuleV2Create,
+		Read:   resourceNetworkingQoSBandwidthLimitRuleV2Read,
+		Update: resourceNetworkingQoSBandwidthLimitRuleV2Update,
+		Delete: resourceNetworkingQoSBandwidthLimitRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"max_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"max_burst_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+
+			"direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "egress",
+				ValidateFunc: validateNetworkingQoSRuleV2Direction,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Opts(d *schema.ResourceData) QoSBandwidthLimitRuleOpts {
+	return QoSBandwidthLimitRuleOpts{
+		MaxKBps:      d.Get("max_kbps").(int),
+		MaxBurstKBps: d.Get("max_burst_kbps").(int),
+		Direction:    d.Get("direction").(string),
+	}
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID := d.Get("qos_policy_id").(string)
+	createOpts := resourceNetworkingQoSBandwidthLimitRuleV2Opts(d)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := networkingQoSRuleV2Create(networkingClient, policyID, networkingQoSBandwidthLimitRule, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS bandwidth limit rule: %s", err)
+	}
+	log.Printf("[INFO] QoS bandwidth limit rule ID: %s", rule.ID)
+
+	d.SetId(fmt.Sprintf("%s/%s", policyID, rule.ID))
+
+	return resourceNetworkingQoSBandwidthLimitRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := networkingQoSRuleV2Get(networkingClient, policyID, networkingQoSBandwidthLimitRule, ruleID)
+	if err != nil {
+		return CheckDeleted(d, err, "QoS bandwidth limit rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack Neutron QoS bandwidth limit rule %s: %+v", d.Id(), rule)
+
+	d.Set("qos_policy_id", policyID)
+	d.Set("max_kbps", rule.MaxKBps)
+	d.Set("max_burst_kbps", rule.MaxBurstKBps)
+	d.Set("direction", rule.Direction)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := resourceNetworkingQoSBandwidthLimitRuleV2Opts(d)
+
+	log.Printf("[DEBUG] Updating OpenStack Neutron QoS bandwidth limit rule %s with options: %+v", d.Id(), updateOpts)
+
+	if err := networkingQoSRuleV2Update(networkingClient, policyID, networkingQoSBandwidthLimitRule, ruleID, updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS bandwidth limit rule: %s", err)
+	}
+
+	return resourceNetworkingQoSBandwidthLimitRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := networkingQoSRuleV2Delete(networkingClient, policyID, networkingQoSBandwidthLimitRule, ruleID); err != nil {
+		return CheckDeleted(d, err, "QoS bandwidth limit rule")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,144 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQoSDSCPMarkingRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSDSCPMarkingRuleV2Create,
+		Read:   resourceNetworkingQoSDSCPMarkingRuleV2Read,
+		Update: resourceNetworkingQoSDSCPMarkingRuleV2Update,
+		Delete: resourceNetworkingQoSDSCPMarkingRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"dscp_mark": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if _, ok := networkingQoSDSCPMarks[value]; !ok {
+						errors = append(errors, fmt.Errorf("%d is not a valid DSCP mark for %q", value, k))
+					}
+					return
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Opts(d *schema.ResourceData) QoSDSCPMarkingRuleOpts {
+	return QoSDSCPMarkingRuleOpts{
+		DSCPMark: d.Get("dscp_mark").(int),
+	}
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID := d.Get("qos_policy_id").(string)
+	createOpts := resourceNetworkingQoSDSCPMarkingRuleV2Opts(d)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := networkingQoSRuleV2Create(networkingClient, policyID, networkingQoSDSCPMarkingRule, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS DSCP marking rule: %s", err)
+	}
+	log.Printf("[INFO] QoS DSCP marking rule ID: %s", rule.ID)
+
+	d.SetId(fmt.Sprintf("%s/%s", policyID, rule.ID))
+
+	return resourceNetworkingQoSDSCPMarkingRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := networkingQoSRuleV2Get(networkingClient, policyID, networkingQoSDSCPMarkingRule, ruleID)
+	if err != nil {
+		return CheckDeleted(d, err, "QoS DSCP marking rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack Neutron QoS DSCP marking rule %s: %+v", d.Id(), rule)
+
+	d.Set("qos_policy_id", policyID)
+	d.Set("dscp_mark", rule.DSCPMark)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := resourceNetworkingQoSDSCPMarkingRuleV2Opts(d)
+
+	log.Printf("[DEBUG] Updating OpenStack Neutron QoS DSCP marking rule %s with options: %+v", d.Id(), updateOpts)
+
+	if err := networkingQoSRuleV2Update(networkingClient, policyID, networkingQoSDSCPMarkingRule, ruleID, updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS DSCP marking rule: %s", err)
+	}
+
+	return resourceNetworkingQoSDSCPMarkingRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := networkingQoSRuleV2Delete(networkingClient, policyID, networkingQoSDSCPMarkingRule, ruleID); err != nil {
+		return CheckDeleted(d, err, "QoS DSCP marking rule")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkingV2QoSDSCPMarkingRule_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSRuleDestroy("openstack_networking_qos_dscp_marking_rule_v2", networkingQoSDSCPMarkingRule),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSDSCPMarkingRule_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSRuleExists("openstack_networking_qos_dscp_marking_rule_v2.rule_1", networkingQoSDSCPMarkingRule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_dscp_marking_rule_v2.rule_1", "dscp_mark", "26"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSDSCPMarkingRule_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_dscp_marking_rule_v2.rule_1", "dscp_mark", "20"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2QoSDSCPMarkingRule_basic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_dscp_marking_rule_v2" "rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  dscp_mark = 26
+}
+`
+
+const testAccNetworkingV2QoSDSCPMarkingRule_update = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_dscp_marking_rule_v2" "rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  dscp_mark = 20
+}
+`
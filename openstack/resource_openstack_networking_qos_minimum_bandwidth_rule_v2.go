@@ -0,0 +1,146 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSMinimumBandwidthRuleV2Create,
+		Read:   resourceNetworkingQoSMinimumBandwidthRuleV2Read,
+		Update: resourceNetworkingQoSMinimumBandwidthRuleV2Update,
+		Delete: resourceNetworkingQoSMinimumBandwidthRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"min_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "egress",
+				ValidateFunc: validateNetworkingQoSRuleV2Direction,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Opts(d *schema.ResourceData) QoSMinimumBandwidthRuleOpts {
+	return QoSMinimumBandwidthRuleOpts{
+		MinKBps:   d.Get("min_kbps").(int),
+		Direction: d.Get("direction").(string),
+	}
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID := d.Get("qos_policy_id").(string)
+	createOpts := resourceNetworkingQoSMinimumBandwidthRuleV2Opts(d)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := networkingQoSRuleV2Create(networkingClient, policyID, networkingQoSMinimumBandwidthRule, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS minimum bandwidth rule: %s", err)
+	}
+	log.Printf("[INFO] QoS minimum bandwidth rule ID: %s", rule.ID)
+
+	d.SetId(fmt.Sprintf("%s/%s", policyID, rule.ID))
+
+	return resourceNetworkingQoSMinimumBandwidthRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := networkingQoSRuleV2Get(networkingClient, policyID, networkingQoSMinimumBandwidthRule, ruleID)
+	if err != nil {
+		return CheckDeleted(d, err, "QoS minimum bandwidth rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack Neutron QoS minimum bandwidth rule %s: %+v", d.Id(), rule)
+
+	d.Set("qos_policy_id", policyID)
+	d.Set("min_kbps", rule.MinKBps)
+	d.Set("direction", rule.Direction)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := resourceNetworkingQoSMinimumBandwidthRuleV2Opts(d)
+
+	log.Printf("[DEBUG] Updating OpenStack Neutron QoS minimum bandwidth rule %s with options: %+v", d.Id(), updateOpts)
+
+	if err := networkingQoSRuleV2Update(networkingClient, policyID, networkingQoSMinimumBandwidthRule, ruleID, updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS minimum bandwidth rule: %s", err)
+	}
+
+	return resourceNetworkingQoSMinimumBandwidthRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := networkingQoSRuleV2Delete(networkingClient, policyID, networkingQoSMinimumBandwidthRule, ruleID); err != nil {
+		return CheckDeleted(d, err, "QoS minimum bandwidth rule")
+	}
+
+	d.SetId("")
+	return nil
+}
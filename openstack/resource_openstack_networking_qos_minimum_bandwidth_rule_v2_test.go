@@ -0,0 +1,57 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccNetworkingV2QoSMinimumBandwidthRule_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSRuleDestroy("openstack_networking_qos_minimum_bandwidth_rule_v2", networkingQoSMinimumBandwidthRule),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSMinimumBandwidthRule_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSRuleExists("openstack_networking_qos_minimum_bandwidth_rule_v2.rule_1", networkingQoSMinimumBandwidthRule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_bandwidth_rule_v2.rule_1", "min_kbps", "200"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSMinimumBandwidthRule_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_minimum_bandwidth_rule_v2.rule_1", "min_kbps", "500"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2QoSMinimumBandwidthRule_basic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_bandwidth_rule_v2" "rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kbps = 200
+}
+`
+
+const testAccNetworkingV2QoSMinimumBandwidthRule_update = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_bandwidth_rule_v2" "rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kbps = 500
+}
+`
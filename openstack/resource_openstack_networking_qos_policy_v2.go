@@ -0,0 +1,157 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQoSPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSPolicyV2Create,
+		Read:   resourceNetworkingQoSPolicyV2Read,
+		Update: resourceNetworkingQoSPolicyV2Update,
+		Delete: resourceNetworkingQoSPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := QoSPolicyCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TenantID:    d.Get("tenant_id").(string),
+		Shared:      d.Get("shared").(bool),
+		IsDefault:   d.Get("is_default").(bool),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	policy, err := networkingQoSPolicyV2Create(networkingClient, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS policy: %s", err)
+	}
+	log.Printf("[INFO] QoS policy ID: %s", policy.ID)
+
+	d.SetId(policy.ID)
+
+	return resourceNetworkingQoSPolicyV2Read(d, meta)
+}
+
+func resourceNetworkingQoSPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policy, err := networkingQoSPolicyV2Get(networkingClient, d.Id())
+	if err != nil {
+		return CheckDeleted(d, err, "QoS policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack Neutron QoS policy %s: %+v", d.Id(), policy)
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("tenant_id", policy.TenantID)
+	d.Set("shared", policy.Shared)
+	d.Set("is_default", policy.IsDefault)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceNetworkingQoSPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts QoSPolicyUpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("shared") {
+		shared := d.Get("shared").(bool)
+		updateOpts.Shared = &shared
+	}
+	if d.HasChange("is_default") {
+		isDefault := d.Get("is_default").(bool)
+		updateOpts.IsDefault = &isDefault
+	}
+
+	log.Printf("[DEBUG] Updating OpenStack Neutron QoS policy %s with options: %+v", d.Id(), updateOpts)
+
+	if err := networkingQoSPolicyV2Update(networkingClient, d.Id(), updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS policy: %s", err)
+	}
+
+	return resourceNetworkingQoSPolicyV2Read(d, meta)
+}
+
+func resourceNetworkingQoSPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingQoSPolicyV2Delete(networkingClient, d.Id()); err != nil {
+		return CheckDeleted(d, err, "QoS policy")
+	}
+
+	d.SetId("")
+	return nil
+}
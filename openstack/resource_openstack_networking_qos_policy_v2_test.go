@@ -0,0 +1,109 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2QoSPolicy_basic(t *testing.T) {
+	var policy QoSPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSPolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSPolicy_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSPolicyExists("openstack_networking_qos_policy_v2.qos_policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "shared", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSPolicy_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "description", "qos_policy_1 updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_policy_v2.qos_policy_1", "shared", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QoSPolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_qos_policy_v2" {
+			continue
+		}
+
+		_, err := networkingQoSPolicyV2Get(networkingClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("QoS policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2QoSPolicyExists(n string, policy *QoSPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingQoSPolicyV2Get(networkingClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("QoS policy not found")
+		}
+
+		*policy = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2QoSPolicy_basic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+  description = "qos_policy_1"
+}
+`
+
+const testAccNetworkingV2QoSPolicy_update = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+  description = "qos_policy_1 updated"
+  shared = true
+}
+`
@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingRouterConntrackHelperV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingRouterConntrackHelperV2Create,
+		Read:   resourceNetworkingRouterConntrackHelperV2Read,
+		Update: resourceNetworkingRouterConntrackHelperV2Update,
+		Delete: resourceNetworkingRouterConntrackHelperV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+
+			"router_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"helper": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingRouterConntrackHelperV2Opts(d *schema.ResourceData) RouterConntrackHelperOpts {
+	return RouterConntrackHelperOpts{
+		Protocol: d.Get("protocol").(string),
+		Port:     d.Get("port").(int),
+		Helper:   d.Get("helper").(string),
+	}
+}
+
+func resourceNetworkingRouterConntrackHelperV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	createOpts := resourceNetworkingRouterConntrackHelperV2Opts(d)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	helper, err := networkingRouterConntrackHelperV2Create(networkingClient, routerID, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron router conntrack helper: %s", err)
+	}
+	log.Printf("[INFO] Router conntrack helper ID: %s", helper.ID)
+
+	d.SetId(fmt.Sprintf("%s/%s", routerID, helper.ID))
+
+	return resourceNetworkingRouterConntrackHelperV2Read(d, meta)
+}
+
+func resourceNetworkingRouterConntrackHelperV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, helperID, err := parseNetworkingRouterConntrackHelperV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	helper, err := networkingRouterConntrackHelperV2Get(networkingClient, routerID, helperID)
+	if err != nil {
+		return CheckDeleted(d, err, "router conntrack helper")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack Neutron router conntrack helper %s: %+v", d.Id(), helper)
+
+	d.Set("router_id", routerID)
+	d.Set("protocol", helper.Protocol)
+	d.Set("port", helper.Port)
+	d.Set("helper", helper.Helper)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceNetworkingRouterConntrackHelperV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, helperID, err := parseNetworkingRouterConntrackHelperV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := resourceNetworkingRouterConntrackHelperV2Opts(d)
+
+	log.Printf("[DEBUG] Updating OpenStack Neutron router conntrack helper %s with options: %+v", d.Id(), updateOpts)
+
+	if err := networkingRouterConntrackHelperV2Update(networkingClient, routerID, helperID, updateOpts); err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron router conntrack helper: %s", err)
+	}
+
+	return resourceNetworkingRouterConntrackHelperV2Read(d, meta)
+}
+
+func resourceNetworkingRouterConntrackHelperV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, helperID, err := parseNetworkingRouterConntrackHelperV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := networkingRouterConntrackHelperV2Delete(networkingClient, routerID, helperID); err != nil {
+		return CheckDeleted(d, err, "router conntrack helper")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,139 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2RouterConntrackHelper_basic(t *testing.T) {
+	var helper RouterConntrackHelper
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterConntrackHelperDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2RouterConntrackHelper_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterConntrackHelperExists(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", &helper),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "protocol", "tcp"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "port", "21"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "helper", "ftp"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2RouterConntrackHelper_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "protocol", "udp"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "port", "69"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_conntrack_helper_v2.helper_1", "helper", "tftp"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "openstack_networking_router_conntrack_helper_v2.helper_1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2RouterConntrackHelperDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_router_conntrack_helper_v2" {
+			continue
+		}
+
+		routerID, helperID, err := parseNetworkingRouterConntrackHelperV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = networkingRouterConntrackHelperV2Get(networkingClient, routerID, helperID)
+		if err == nil {
+			return fmt.Errorf("Router conntrack helper still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2RouterConntrackHelperExists(n string, helper *RouterConntrackHelper) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		routerID, helperID, err := parseNetworkingRouterConntrackHelperV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := networkingRouterConntrackHelperV2Get(networkingClient, routerID, helperID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != helperID {
+			return fmt.Errorf("Router conntrack helper not found")
+		}
+
+		*helper = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2RouterConntrackHelper_basic = `
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+}
+
+resource "openstack_networking_router_conntrack_helper_v2" "helper_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  protocol = "tcp"
+  port = 21
+  helper = "ftp"
+}
+`
+
+const testAccNetworkingV2RouterConntrackHelper_update = `
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+}
+
+resource "openstack_networking_router_conntrack_helper_v2" "helper_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  protocol = "udp"
+  port = 69
+  helper = "tftp"
+}
+`
@@ -0,0 +1,162 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+)
+
+func resourceNetworkingRouterRoutesV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingRouterRoutesV2Create,
+		Read:   resourceNetworkingRouterRoutesV2Read,
+		Update: resourceNetworkingRouterRoutesV2Update,
+		Delete: resourceNetworkingRouterRoutesV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
+			},
+			"router_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"next_hop": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkingRouterRoutesV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerId := d.Get("router_id").(string)
+	if err := networkingRouterRoutesV2Set(networkingClient, routerId, resourceRouterRoutesV2(d)); err != nil {
+		return fmt.Errorf("Error setting the routes of OpenStack Neutron Router %s: %s", routerId, err)
+	}
+
+	d.SetId(routerId)
+
+	return resourceNetworkingRouterRoutesV2Read(d, meta)
+}
+
+func resourceNetworkingRouterRoutesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	n, err := routers.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "router")
+	}
+
+	log.Printf("[DEBUG] Retrieved routes of Router %s: %+v", d.Id(), n.Routes)
+
+	routes := make([]map[string]interface{}, len(n.Routes))
+	for i, r := range n.Routes {
+		routes[i] = map[string]interface{}{
+			"destination_cidr": r.DestinationCIDR,
+			"next_hop":         r.NextHop,
+		}
+	}
+
+	d.Set("router_id", d.Id())
+	d.Set("route", routes)
+	d.Set("region", GetRegion(d))
+
+	return nil
+}
+
+func resourceNetworkingRouterRoutesV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if d.HasChange("route") {
+		if err := networkingRouterRoutesV2Set(networkingClient, d.Id(), resourceRouterRoutesV2(d)); err != nil {
+			return fmt.Errorf("Error updating the routes of OpenStack Neutron Router %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceNetworkingRouterRoutesV2Read(d, meta)
+}
+
+func resourceNetworkingRouterRoutesV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if err := networkingRouterRoutesV2Set(networkingClient, d.Id(), []routers.Route{}); err != nil {
+		return CheckDeleted(d, err, "Error deleting the routes of OpenStack Neutron Router")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceRouterRoutesV2(d *schema.ResourceData) []routers.Route {
+	rawRoutes := d.Get("route").(*schema.Set).List()
+
+	routes := make([]routers.Route, len(rawRoutes))
+	for i, raw := range rawRoutes {
+		rawMap := raw.(map[string]interface{})
+		routes[i] = routers.Route{
+			DestinationCIDR: rawMap["destination_cidr"].(string),
+			NextHop:         rawMap["next_hop"].(string),
+		}
+	}
+
+	return routes
+}
+
+// networkingRouterRoutesV2Set replaces all the routes of a router in a single
+// update, so that no route is lost to concurrent read-modify-write cycles.
+func networkingRouterRoutesV2Set(networkingClient *gophercloud.ServiceClient, routerId string, routes []routers.Route) error {
+	osMutexKV.Lock(routerId)
+	defer osMutexKV.Unlock(routerId)
+
+	log.Printf("[DEBUG] Setting the routes of Router %s to: %+v", routerId, routes)
+
+	b := map[string]interface{}{
+		"router": map[string]interface{}{
+			"routes": routes,
+		},
+	}
+	url := networkingClient.ServiceURL("routers", routerId)
+	return networkingV2Update(networkingClient, url, b, 0)
+}
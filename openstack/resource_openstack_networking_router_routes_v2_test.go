@@ -0,0 +1,102 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+)
+
+func TestAccNetworkingV2RouterRoutes_basic(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2RouterRoutes_create,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_routes_v2.routes_1", "route.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2RouterRoutes_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_routes_v2.routes_1", "route.#", "3"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2RouterRoutes_base,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterRouteEmpty("openstack_networking_router_v2.router_1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNetworkingV2RouterRoutes_base = `
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_interface_v2" "int_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+`
+
+var testAccNetworkingV2RouterRoutes_create = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_router_routes_v2" "routes_1" {
+  router_id = "${openstack_networking_router_interface_v2.int_1.router_id}"
+
+  route {
+    destination_cidr = "10.0.1.0/24"
+    next_hop = "192.168.199.254"
+  }
+}
+`, testAccNetworkingV2RouterRoutes_base)
+
+var testAccNetworkingV2RouterRoutes_update = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_router_routes_v2" "routes_1" {
+  router_id = "${openstack_networking_router_interface_v2.int_1.router_id}"
+
+  route {
+    destination_cidr = "10.0.1.0/24"
+    next_hop = "192.168.199.253"
+  }
+
+  route {
+    destination_cidr = "10.0.2.0/24"
+    next_hop = "192.168.199.254"
+  }
+
+  route {
+    destination_cidr = "10.0.3.0/24"
+    next_hop = "192.168.199.254"
+  }
+}
+`, testAccNetworkingV2RouterRoutes_base)
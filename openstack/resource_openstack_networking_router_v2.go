@@ -3,6 +3,7 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -45,7 +46,11 @@ func resourceNetworkingRouterV2() *schema.Resource {
 			"distributed": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
+			},
+			"ha": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"external_gateway": &schema.Schema{
@@ -53,12 +58,48 @@ func resourceNetworkingRouterV2() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"external_fixed_ip": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"ip_address": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tenant_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 				Computed: true,
 			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"all_tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -80,6 +121,8 @@ func resourceNetworkingRouterV2Create(d *schema.ResourceData, meta interface{})
 			Name:     d.Get("name").(string),
 			TenantID: d.Get("tenant_id").(string),
 		},
+		resourceRouterGatewayInfoV2(d),
+		nil,
 		MapValueSpecs(d),
 	}
 
@@ -93,12 +136,13 @@ func resourceNetworkingRouterV2Create(d *schema.ResourceData, meta interface{})
 		createOpts.Distributed = &d
 	}
 
-	externalGateway := d.Get("external_gateway").(string)
-	if externalGateway != "" {
-		gatewayInfo := routers.GatewayInfo{
-			NetworkID: externalGateway,
+	haRaw := d.Get("ha").(string)
+	if haRaw != "" {
+		ha, err := strconv.ParseBool(haRaw)
+		if err != nil {
+			return fmt.Errorf("ha, if provided, must be either 'true' or 'false'")
 		}
-		createOpts.GatewayInfo = &gatewayInfo
+		createOpts.HA = &ha
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -122,6 +166,10 @@ func resourceNetworkingRouterV2Create(d *schema.ResourceData, meta interface{})
 
 	d.SetId(n.ID)
 
+	if err := networkingV2SetTags(networkingClient, config, d, "routers"); err != nil {
+		return err
+	}
+
 	return resourceNetworkingRouterV2Read(d, meta)
 }
 
@@ -132,7 +180,8 @@ func resourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	n, err := routers.Get(networkingClient, d.Id()).Extract()
+	r := routers.Get(networkingClient, d.Id())
+	n, err := r.Extract()
 	if err != nil {
 		if _, ok := err.(gophercloud.ErrDefault404); ok {
 			d.SetId("")
@@ -144,12 +193,37 @@ func resourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Retrieved Router %s: %+v", d.Id(), n)
 
+	var routerWithExtensions struct {
+		Router struct {
+			Tags                []string          `json:"tags"`
+			HA                  *bool             `json:"ha"`
+			ExternalGatewayInfo RouterGatewayInfo `json:"external_gateway_info"`
+		} `json:"router"`
+	}
+	if err := r.ExtractInto(&routerWithExtensions); err != nil {
+		return fmt.Errorf("Error extracting extensions of router %s: %s", d.Id(), err)
+	}
+
 	d.Set("name", n.Name)
 	d.Set("admin_state_up", n.AdminStateUp)
 	d.Set("distributed", n.Distributed)
+	if routerWithExtensions.Router.HA != nil {
+		d.Set("ha", strconv.FormatBool(*routerWithExtensions.Router.HA))
+	}
 	d.Set("tenant_id", n.TenantID)
 	d.Set("external_gateway", n.GatewayInfo.NetworkID)
 
+	externalFixedIPs := make([]map[string]interface{}, len(routerWithExtensions.Router.ExternalGatewayInfo.ExternalFixedIPs))
+	for i, ip := range routerWithExtensions.Router.ExternalGatewayInfo.ExternalFixedIPs {
+		externalFixedIPs[i] = map[string]interface{}{
+			"subnet_id":  ip.SubnetID,
+			"ip_address": ip.IPAddress,
+		}
+	}
+	d.Set("external_fixed_ip", externalFixedIPs)
+
+	networkingV2ReadAttributesTags(d, config, routerWithExtensions.Router.Tags)
+
 	return nil
 }
 
@@ -164,6 +238,12 @@ func resourceNetworkingRouterV2Update(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	if d.HasChange("distributed") || d.HasChange("ha") {
+		if err := resourceNetworkingRouterV2UpdateMode(networkingClient, d); err != nil {
+			return err
+		}
+	}
+
 	var updateOpts routers.UpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
@@ -172,23 +252,40 @@ func resourceNetworkingRouterV2Update(d *schema.ResourceData, meta interface{})
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
-	if d.HasChange("external_gateway") {
-		externalGateway := d.Get("external_gateway").(string)
-		if externalGateway != "" {
-			gatewayInfo := routers.GatewayInfo{
-				NetworkID: externalGateway,
-			}
-			updateOpts.GatewayInfo = &gatewayInfo
-		}
-	}
 
 	log.Printf("[DEBUG] Updating Router %s with options: %+v", d.Id(), updateOpts)
 
-	_, err = routers.Update(networkingClient, d.Id(), updateOpts).Extract()
+	b, err := updateOpts.ToRouterUpdateMap()
 	if err != nil {
+		return fmt.Errorf("Error building update request for OpenStack Neutron Router: %s", err)
+	}
+
+	// routers.UpdateOpts always sends the routes, and an empty list clears
+	// them. They are left to the route resources.
+	delete(b["router"].(map[string]interface{}), "routes")
+
+	// The gateway is sent with its external fixed IPs, which
+	// routers.GatewayInfo can't hold. When only the gateway changes, the
+	// fixed IPs in the state belong to the previous external network, so
+	// Neutron picks new ones.
+	if d.HasChange("external_gateway") || d.HasChange("external_fixed_ip") {
+		if gatewayInfo := resourceRouterGatewayInfoV2(d); gatewayInfo != nil {
+			if !d.HasChange("external_fixed_ip") {
+				gatewayInfo.ExternalFixedIPs = nil
+			}
+			b["router"].(map[string]interface{})["external_gateway_info"] = gatewayInfo
+		}
+	}
+
+	url := networkingClient.ServiceURL("routers", d.Id())
+	if err := networkingV2Update(networkingClient, url, b, 0); err != nil {
 		return fmt.Errorf("Error updating OpenStack Neutron Router: %s", err)
 	}
 
+	if err := networkingV2SetTags(networkingClient, config, d, "routers"); err != nil {
+		return err
+	}
+
 	return resourceNetworkingRouterV2Read(d, meta)
 }
 
@@ -217,6 +314,71 @@ func resourceNetworkingRouterV2Delete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// resourceRouterGatewayInfoV2 returns the external gateway of a router, or nil
+// if it has none. The external fixed IPs are only used along with a gateway.
+func resourceRouterGatewayInfoV2(d *schema.ResourceData) *RouterGatewayInfo {
+	externalGateway := d.Get("external_gateway").(string)
+	if externalGateway == "" {
+		return nil
+	}
+
+	rawIPs := d.Get("external_fixed_ip").([]interface{})
+	ips := make([]RouterExternalFixedIP, len(rawIPs))
+	for i, raw := range rawIPs {
+		rawMap := raw.(map[string]interface{})
+		ips[i] = RouterExternalFixedIP{
+			SubnetID:  rawMap["subnet_id"].(string),
+			IPAddress: rawMap["ip_address"].(string),
+		}
+	}
+
+	return &RouterGatewayInfo{
+		NetworkID:        externalGateway,
+		ExternalFixedIPs: ips,
+	}
+}
+
+// resourceNetworkingRouterV2UpdateMode changes whether a router is distributed
+// or highly available. Neutron only allows it while the router is
+// administratively down, so the router is set down for the update and then
+// brought back to its configured admin_state_up, even if the update failed.
+func resourceNetworkingRouterV2UpdateMode(networkingClient *gophercloud.ServiceClient, d *schema.ResourceData) error {
+	router := make(map[string]interface{})
+	if d.HasChange("distributed") {
+		router["distributed"] = d.Get("distributed").(bool)
+	}
+	if d.HasChange("ha") {
+		ha, err := strconv.ParseBool(d.Get("ha").(string))
+		if err != nil {
+			return fmt.Errorf("ha, if provided, must be either 'true' or 'false'")
+		}
+		router["ha"] = ha
+	}
+
+	log.Printf("[DEBUG] Setting Router %s down to update it with options: %+v", d.Id(), router)
+
+	// The bodies are built here since routers.UpdateOpts always sends the
+	// routes, which would clear them.
+	url := networkingClient.ServiceURL("routers", d.Id())
+	down := map[string]interface{}{"router": map[string]interface{}{"admin_state_up": false}}
+	if err := networkingV2Update(networkingClient, url, down, 0); err != nil {
+		return fmt.Errorf("Error setting OpenStack Neutron Router %s down: %s", d.Id(), err)
+	}
+
+	updateErr := networkingV2Update(networkingClient, url, map[string]interface{}{"router": router}, 0)
+
+	restore := map[string]interface{}{"router": map[string]interface{}{"admin_state_up": d.Get("admin_state_up").(bool)}}
+	if err := networkingV2Update(networkingClient, url, restore, 0); err != nil {
+		return fmt.Errorf("Error restoring admin_state_up of OpenStack Neutron Router %s: %s", d.Id(), err)
+	}
+
+	if updateErr != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron Router: %s", updateErr)
+	}
+
+	return nil
+}
+
 func waitForRouterActive(networkingClient *gophercloud.ServiceClient, routerId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		r, err := routers.Get(networkingClient, routerId).Extract()
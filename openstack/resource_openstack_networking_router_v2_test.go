@@ -60,6 +60,30 @@ func TestAccNetworkingV2Router_update_external_gw(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Router_externalFixedIP(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Router_update_external_gw_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "external_fixed_ip.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_router_v2.router_1", "external_fixed_ip.0.subnet_id"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_router_v2.router_1", "external_fixed_ip.0.ip_address"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkingV2Router_timeout(t *testing.T) {
 	var router routers.Router
 
@@ -78,6 +102,38 @@ func TestAccNetworkingV2Router_timeout(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Router_ha(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Router_ha_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "ha", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Router_ha_2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "ha", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "admin_state_up", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2RouterDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -176,3 +232,21 @@ resource "openstack_networking_router_v2" "router_1" {
   }
 }
 `
+
+const testAccNetworkingV2Router_ha_1 = `
+resource "openstack_networking_router_v2" "router_1" {
+	name = "router_1"
+	admin_state_up = "true"
+	distributed = "false"
+	ha = "false"
+}
+`
+
+const testAccNetworkingV2Router_ha_2 = `
+resource "openstack_networking_router_v2" "router_1" {
+	name = "router_1"
+	admin_state_up = "true"
+	distributed = "false"
+	ha = "true"
+}
+`
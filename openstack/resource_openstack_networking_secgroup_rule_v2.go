@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -34,6 +35,11 @@ func resourceNetworkingSecGroupRuleV2() *schema.Resource {
 				ForceNew:    true,
 				DefaultFunc: schema.EnvDefaultFunc("OS_REGION_NAME", ""),
 			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"direction": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -88,6 +94,10 @@ func resourceNetworkingSecGroupRuleV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"rule_hash": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -110,13 +120,16 @@ func resourceNetworkingSecGroupRuleV2Create(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	opts := rules.CreateOpts{
-		SecGroupID:     d.Get("security_group_id").(string),
-		PortRangeMin:   d.Get("port_range_min").(int),
-		PortRangeMax:   d.Get("port_range_max").(int),
-		RemoteGroupID:  d.Get("remote_group_id").(string),
-		RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
-		TenantID:       d.Get("tenant_id").(string),
+	opts := SecGroupRuleCreateOpts{
+		CreateOpts: rules.CreateOpts{
+			SecGroupID:     d.Get("security_group_id").(string),
+			PortRangeMin:   d.Get("port_range_min").(int),
+			PortRangeMax:   d.Get("port_range_max").(int),
+			RemoteGroupID:  d.Get("remote_group_id").(string),
+			RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
+			TenantID:       d.Get("tenant_id").(string),
+		},
+		Description: d.Get("description").(string),
 	}
 
 	if v, ok := d.GetOk("direction"); ok {
@@ -157,12 +170,23 @@ func resourceNetworkingSecGroupRuleV2Read(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	security_group_rule, err := rules.Get(networkingClient, d.Id()).Extract()
+	r := rules.Get(networkingClient, d.Id())
+	security_group_rule, err := r.Extract()
 
 	if err != nil {
 		return CheckDeleted(d, err, "OpenStack Security Group Rule")
 	}
 
+	var ruleWithExtensions struct {
+		Rule struct {
+			Description string `json:"description"`
+		} `json:"security_group_rule"`
+	}
+	if err := r.ExtractInto(&ruleWithExtensions); err != nil {
+		return fmt.Errorf("Error extracting OpenStack Security Group Rule %s: %s", d.Id(), err)
+	}
+
+	d.Set("description", ruleWithExtensions.Rule.Description)
 	d.Set("direction", security_group_rule.Direction)
 	d.Set("ethertype", security_group_rule.EtherType)
 	d.Set("protocol", security_group_rule.Protocol)
@@ -172,6 +196,7 @@ func resourceNetworkingSecGroupRuleV2Read(d *schema.ResourceData, meta interface
 	d.Set("remote_ip_prefix", security_group_rule.RemoteIPPrefix)
 	d.Set("security_group_id", security_group_rule.SecGroupID)
 	d.Set("tenant_id", security_group_rule.TenantID)
+	d.Set("rule_hash", networkingSecGroupRuleV2Hash(*security_group_rule))
 	d.Set("region", GetRegion(d))
 
 	return nil
@@ -314,3 +339,13 @@ func waitForSecGroupRuleDelete(networkingClient *gophercloud.ServiceClient, secG
 		return r, "ACTIVE", nil
 	}
 }
+
+// networkingSecGroupRuleV2Hash returns a key identifying a rule by what it
+// allows rather than by its ID. Neutron refuses duplicate rules, so the key
+// is unique within a security group, and it is the same whether the rule is
+// read from its security group or on its own.
+func networkingSecGroupRuleV2Hash(rule rules.SecGroupRule) string {
+	return strconv.Itoa(hashcode.String(fmt.Sprintf("%s-%s-%s-%d-%d-%s-%s",
+		rule.Direction, rule.EtherType, rule.Protocol, rule.PortRangeMin, rule.PortRangeMax,
+		rule.RemoteIPPrefix, rule.RemoteGroupID)))
+}
@@ -33,6 +33,10 @@ func TestAccNetworkingV2SecGroupRule_basic(t *testing.T) {
 						"openstack_networking_secgroup_rule_v2.secgroup_rule_1", &secgroup_rule_1),
 					testAccCheckNetworkingV2SecGroupRuleExists(
 						"openstack_networking_secgroup_rule_v2.secgroup_rule_2", &secgroup_rule_2),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_rule_v2.secgroup_rule_1", "description", "ssh from anywhere"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_secgroup_rule_v2.secgroup_rule_2", "description", ""),
 				),
 			},
 		},
@@ -218,6 +222,39 @@ func TestAccNetworkingV2SecGroupRule_numericProtocol(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2SecGroupRule_ruleIds(t *testing.T) {
+	var secgroup_1 groups.SecGroup
+	var secgroup_rule_1 rules.SecGroupRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SecGroupRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2SecGroupRule_lowerCaseCIDR,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupExists(
+						"openstack_networking_secgroup_v2.secgroup_1", &secgroup_1),
+					testAccCheckNetworkingV2SecGroupRuleExists(
+						"openstack_networking_secgroup_rule_v2.secgroup_rule_1", &secgroup_rule_1),
+					resource.TestCheckResourceAttrSet(
+						"openstack_networking_secgroup_rule_v2.secgroup_rule_1", "rule_hash"),
+				),
+			},
+			// The security group only sees the rule once it is refreshed.
+			resource.TestStep{
+				Config: testAccNetworkingV2SecGroupRule_lowerCaseCIDR,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SecGroupRuleInRuleIds(
+						"openstack_networking_secgroup_v2.secgroup_1",
+						"openstack_networking_secgroup_rule_v2.secgroup_rule_1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2SecGroupRuleDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -271,6 +308,28 @@ func testAccCheckNetworkingV2SecGroupRuleExists(n string, security_group_rule *r
 	}
 }
 
+func testAccCheckNetworkingV2SecGroupRuleInRuleIds(secgroupName, ruleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		secgroup, ok := s.RootModule().Resources[secgroupName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", secgroupName)
+		}
+
+		rule, ok := s.RootModule().Resources[ruleName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", ruleName)
+		}
+
+		key := "rule_ids." + rule.Primary.Attributes["rule_hash"]
+		if secgroup.Primary.Attributes[key] != rule.Primary.ID {
+			return fmt.Errorf("Expected %s to be %s, got %s",
+				key, rule.Primary.ID, secgroup.Primary.Attributes[key])
+		}
+
+		return nil
+	}
+}
+
 const testAccNetworkingV2SecGroupRule_basic = `
 resource "openstack_networking_secgroup_v2" "secgroup_1" {
   name = "secgroup_1"
@@ -290,6 +349,7 @@ resource "openstack_networking_secgroup_rule_v2" "secgroup_rule_1" {
   protocol = "tcp"
   remote_ip_prefix = "0.0.0.0/0"
   security_group_id = "${openstack_networking_secgroup_v2.secgroup_1.id}"
+  description = "ssh from anywhere"
 }
 
 resource "openstack_networking_secgroup_rule_v2" "secgroup_rule_2" {
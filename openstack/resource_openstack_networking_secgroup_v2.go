@@ -54,6 +54,27 @@ func resourceNetworkingSecGroupV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"all_tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rule_ids": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -94,6 +115,10 @@ func resourceNetworkingSecGroupV2Create(d *schema.ResourceData, meta interface{}
 
 	d.SetId(security_group.ID)
 
+	if err := networkingV2SetTags(networkingClient, config, d, "security-groups"); err != nil {
+		return err
+	}
+
 	return resourceNetworkingSecGroupV2Read(d, meta)
 }
 
@@ -106,16 +131,35 @@ func resourceNetworkingSecGroupV2Read(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	security_group, err := groups.Get(networkingClient, d.Id()).Extract()
+	r := groups.Get(networkingClient, d.Id())
+	security_group, err := r.Extract()
 
 	if err != nil {
 		return CheckDeleted(d, err, "OpenStack Neutron Security group")
 	}
 
+	var secGroupWithExtensions struct {
+		SecGroup struct {
+			Tags []string `json:"tags"`
+		} `json:"security_group"`
+	}
+	if err := r.ExtractInto(&secGroupWithExtensions); err != nil {
+		return fmt.Errorf("Error extracting tags of security group %s: %s", d.Id(), err)
+	}
+
 	d.Set("description", security_group.Description)
 	d.Set("tenant_id", security_group.TenantID)
 	d.Set("name", security_group.Name)
 	d.Set("region", GetRegion(d))
+	networkingV2ReadAttributesTags(d, config, secGroupWithExtensions.SecGroup.Tags)
+
+	// Map the rules of the group, including the ones managed elsewhere, by
+	// the hash openstack_networking_secgroup_rule_v2 exports as rule_hash.
+	ruleIds := make(map[string]string)
+	for _, rule := range security_group.Rules {
+		ruleIds[networkingSecGroupRuleV2Hash(rule)] = rule.ID
+	}
+	d.Set("rule_ids", ruleIds)
 
 	return nil
 }
@@ -148,6 +192,10 @@ func resourceNetworkingSecGroupV2Update(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if err := networkingV2SetTags(networkingClient, config, d, "security-groups"); err != nil {
+		return err
+	}
+
 	return resourceNetworkingSecGroupV2Read(d, meta)
 }
 
@@ -119,11 +119,37 @@ func resourceNetworkingSubnetV2() *schema.Resource {
 					},
 				},
 			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"all_tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ignore_default_tags": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
 				ForceNew: true,
 			},
+			"subnetpool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"revision_number": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -147,6 +173,7 @@ func resourceNetworkingSubnetV2Create(d *schema.ResourceData, meta interface{})
 			EnableDHCP:      nil,
 		},
 		MapValueSpecs(d),
+		d.Get("subnetpool_id").(string),
 	}
 
 	noGateway := d.Get("no_gateway").(bool)
@@ -191,6 +218,10 @@ func resourceNetworkingSubnetV2Create(d *schema.ResourceData, meta interface{})
 
 	d.SetId(s.ID)
 
+	if err := networkingV2SetTags(networkingClient, config, d, "subnets"); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Created Subnet %s: %#v", s.ID, s)
 	return resourceNetworkingSubnetV2Read(d, meta)
 }
@@ -202,13 +233,25 @@ func resourceNetworkingSubnetV2Read(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	s, err := subnets.Get(networkingClient, d.Id()).Extract()
+	r := subnets.Get(networkingClient, d.Id())
+	s, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "subnet")
 	}
 
 	log.Printf("[DEBUG] Retrieved Subnet %s: %#v", d.Id(), s)
 
+	var subnetWithExtensions struct {
+		Subnet struct {
+			SubnetPoolID   string   `json:"subnetpool_id"`
+			RevisionNumber int      `json:"revision_number"`
+			Tags           []string `json:"tags"`
+		} `json:"subnet"`
+	}
+	if err := r.ExtractInto(&subnetWithExtensions); err != nil {
+		return fmt.Errorf("Error extracting extensions of subnet %s: %s", d.Id(), err)
+	}
+
 	d.Set("network_id", s.NetworkID)
 	d.Set("cidr", s.CIDR)
 	d.Set("ip_version", s.IPVersion)
@@ -219,6 +262,9 @@ func resourceNetworkingSubnetV2Read(d *schema.ResourceData, meta interface{}) er
 	d.Set("host_routes", s.HostRoutes)
 	d.Set("enable_dhcp", s.EnableDHCP)
 	d.Set("network_id", s.NetworkID)
+	d.Set("subnetpool_id", subnetWithExtensions.Subnet.SubnetPoolID)
+	d.Set("revision_number", subnetWithExtensions.Subnet.RevisionNumber)
+	networkingV2ReadAttributesTags(d, config, subnetWithExtensions.Subnet.Tags)
 
 	// Set the allocation_pools
 	var allocationPools []map[string]interface{}
@@ -298,11 +344,37 @@ func resourceNetworkingSubnetV2Update(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] Updating Subnet %s with options: %+v", d.Id(), updateOpts)
 
-	_, err = subnets.Update(networkingClient, d.Id(), updateOpts).Extract()
+	b, err := updateOpts.ToSubnetUpdateMap()
+	if err != nil {
+		return fmt.Errorf("Error building update request for OpenStack Neutron Subnet: %s", err)
+	}
+
+	url := networkingClient.ServiceURL("subnets", d.Id())
+	err = networkingV2Update(networkingClient, url, b, networkingV2RevisionNumber(d, config))
 	if err != nil {
 		return fmt.Errorf("Error updating OpenStack Neutron Subnet: %s", err)
 	}
 
+	if err := networkingV2SetTags(networkingClient, config, d, "subnets"); err != nil {
+		return err
+	}
+
+	// A subnet can't be moved between subnet pools, but a subnet without
+	// a pool can be onboarded into one.
+	if d.HasChange("subnetpool_id") {
+		oldPoolID, newPoolID := d.GetChange("subnetpool_id")
+		if oldPoolID.(string) != "" || newPoolID.(string) == "" {
+			return fmt.Errorf("Error updating OpenStack Neutron Subnet %s: "+
+				"only a subnet without a subnet pool can be onboarded into one", d.Id())
+		}
+
+		networkID := d.Get("network_id").(string)
+		log.Printf("[DEBUG] Onboarding subnets of network %s into subnet pool %s", networkID, newPoolID)
+		if err := onboardNetworkSubnetsV2(networkingClient, newPoolID.(string), networkID); err != nil {
+			return fmt.Errorf("Error onboarding OpenStack Neutron Subnet %s into subnet pool %s: %s", d.Id(), newPoolID, err)
+		}
+	}
+
 	return resourceNetworkingSubnetV2Read(d, meta)
 }
 
@@ -378,6 +450,20 @@ func resourceNetworkingSubnetV2DetermineIPVersion(v int) gophercloud.IPVersion {
 	return ipVersion
 }
 
+// onboardNetworkSubnetsV2 uses the subnet-onboard extension to adopt all
+// subnets of a network into a subnet pool.
+func onboardNetworkSubnetsV2(client *gophercloud.ServiceClient, subnetPoolID string, networkID string) error {
+	b := map[string]interface{}{
+		"network_id": networkID,
+	}
+
+	var res map[string]interface{}
+	_, err := client.Put(client.ServiceURL("subnetpools", subnetPoolID, "onboard_network_subnets"), b, &res, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return err
+}
+
 func waitForSubnetActive(networkingClient *gophercloud.ServiceClient, subnetId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		s, err := subnets.Get(networkingClient, subnetId).Extract()
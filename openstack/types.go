@@ -10,8 +10,13 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/schedulerhints"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
 	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas/firewalls"
@@ -20,6 +25,11 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas/rules"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	secgrouprules "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
@@ -188,11 +198,16 @@ func (opts FirewallUpdateOpts) ToFirewallUpdateMap() (map[string]interface{}, er
 // FloatingIPCreateOpts represents the attributes used when creating a new floating ip.
 type FloatingIPCreateOpts struct {
 	floatingips.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	Description string            `json:"description,omitempty"`
+	QoSPolicyID string            `json:"qos_policy_id,omitempty"`
+	DNSName     string            `json:"dns_name,omitempty"`
+	DNSDomain   string            `json:"dns_domain,omitempty"`
+	ValueSpecs  map[string]string `json:"value_specs,omitempty"`
 }
 
 // ToFloatingIPCreateMap casts a CreateOpts struct to a map.
-// It overrides floatingips.ToFloatingIPCreateMap to add the ValueSpecs field.
+// It overrides floatingips.ToFloatingIPCreateMap to add the Description,
+// QoSPolicyID, DNSName, DNSDomain and ValueSpecs fields.
 func (opts FloatingIPCreateOpts) ToFloatingIPCreateMap() (map[string]interface{}, error) {
 	return BuildRequest(opts, "floatingip")
 }
@@ -200,23 +215,200 @@ func (opts FloatingIPCreateOpts) ToFloatingIPCreateMap() (map[string]interface{}
 // KeyPairCreateOpts represents the attributes used when creating a new keypair.
 type KeyPairCreateOpts struct {
 	keypairs.CreateOpts
+	Type       string            `json:"type,omitempty"`
 	ValueSpecs map[string]string `json:"value_specs,omitempty"`
 }
 
 // ToKeyPairCreateMap casts a CreateOpts struct to a map.
-// It overrides keypairs.ToKeyPairCreateMap to add the ValueSpecs field.
+// It overrides keypairs.ToKeyPairCreateMap to add the Type and ValueSpecs fields.
 func (opts KeyPairCreateOpts) ToKeyPairCreateMap() (map[string]interface{}, error) {
 	return BuildRequest(opts, "keypair")
 }
 
+// PortSecurityGroupsUpdateOpts represents the attributes used when only
+// updating the security groups of a port. ports.UpdateOpts can't be used as
+// it would also reset the allowed address pairs of the port.
+type PortSecurityGroupsUpdateOpts struct {
+	SecurityGroups []string `json:"security_groups"`
+}
+
+// ToPortUpdateMap casts a PortSecurityGroupsUpdateOpts struct to a map.
+func (opts PortSecurityGroupsUpdateOpts) ToPortUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port")
+}
+
+// Listener is an LBaaS v2 listener, including the tags and TLS settings
+// which are only available with Octavia.
+type Listener struct {
+	listeners.Listener
+	Tags          []string `json:"tags"`
+	TLSCiphers    string   `json:"tls_ciphers"`
+	TLSVersions   []string `json:"tls_versions"`
+	ALPNProtocols []string `json:"alpn_protocols"`
+}
+
+// ListenerCreateOpts represents the attributes used when creating a new listener.
+type ListenerCreateOpts struct {
+	listeners.CreateOpts
+	Tags          []string `json:"tags,omitempty"`
+	TLSCiphers    string   `json:"tls_ciphers,omitempty"`
+	TLSVersions   []string `json:"tls_versions,omitempty"`
+	ALPNProtocols []string `json:"alpn_protocols,omitempty"`
+}
+
+// ToListenerCreateMap casts a CreateOpts struct to a map.
+// It overrides listeners.ToListenerCreateMap to add the Tags and TLS fields.
+func (opts ListenerCreateOpts) ToListenerCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "listener")
+}
+
+// ListenerUpdateOpts represents the attributes used when updating a listener.
+type ListenerUpdateOpts struct {
+	listeners.UpdateOpts
+	Tags          *[]string `json:"tags,omitempty"`
+	TLSCiphers    *string   `json:"tls_ciphers,omitempty"`
+	TLSVersions   *[]string `json:"tls_versions,omitempty"`
+	ALPNProtocols *[]string `json:"alpn_protocols,omitempty"`
+}
+
+// ToListenerUpdateMap casts an UpdateOpts struct to a map.
+// It overrides listeners.ToListenerUpdateMap to add the Tags and TLS fields.
+func (opts ListenerUpdateOpts) ToListenerUpdateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "listener")
+}
+
+// LoadBalancer is an LBaaS v2 load balancer, including the flavor ID, the
+// tags and the additional VIPs which are only available with Octavia.
+type LoadBalancer struct {
+	loadbalancers.LoadBalancer
+	FlavorID       string                      `json:"flavor_id"`
+	Tags           []string                    `json:"tags"`
+	AdditionalVips []LoadBalancerAdditionalVip `json:"additional_vips"`
+}
+
+// LoadBalancerAdditionalVip is an additional VIP of a load balancer, such as
+// an IPv6 VIP next to an IPv4 one.
+type LoadBalancerAdditionalVip struct {
+	SubnetID  string `json:"subnet_id"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// LoadBalancerCreateOpts represents the attributes used when creating a new load balancer.
+type LoadBalancerCreateOpts struct {
+	loadbalancers.CreateOpts
+	FlavorID       string                      `json:"flavor_id,omitempty"`
+	Tags           []string                    `json:"tags,omitempty"`
+	VipPortID      string                      `json:"vip_port_id,omitempty"`
+	AdditionalVips []LoadBalancerAdditionalVip `json:"additional_vips,omitempty"`
+}
+
+// ToLoadBalancerCreateMap casts a CreateOpts struct to a map.
+// It overrides loadbalancers.ToLoadBalancerCreateMap to add the FlavorID,
+// Tags, VipPortID and AdditionalVips fields. loadbalancers.CreateOpts
+// requires VipSubnetID, which Octavia takes from the port when VipPortID is
+// given, so it is left out of the request in that case.
+func (opts LoadBalancerCreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	omitVipSubnetID := opts.VipSubnetID == "" && opts.VipPortID != ""
+	if omitVipSubnetID {
+		opts.VipSubnetID = opts.VipPortID
+	}
+
+	b, err := BuildRequest(opts, "loadbalancer")
+	if err != nil {
+		return nil, err
+	}
+
+	if omitVipSubnetID {
+		delete(b["loadbalancer"].(map[string]interface{}), "vip_subnet_id")
+	}
+
+	return b, nil
+}
+
+// LoadBalancerUpdateOpts represents the attributes used when updating a load balancer.
+type LoadBalancerUpdateOpts struct {
+	loadbalancers.UpdateOpts
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// ToLoadBalancerUpdateMap casts an UpdateOpts struct to a map.
+// It overrides loadbalancers.ToLoadBalancerUpdateMap to add the Tags field.
+func (opts LoadBalancerUpdateOpts) ToLoadBalancerUpdateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "loadbalancer")
+}
+
+// Monitor is an LBaaS v2 health monitor, including the HTTP attributes,
+// max_retries_down and tags which are only available with Octavia.
+type Monitor struct {
+	monitors.Monitor
+	MaxRetriesDown int      `json:"max_retries_down"`
+	HTTPVersion    float64  `json:"http_version"`
+	DomainName     string   `json:"domain_name"`
+	Tags           []string `json:"tags"`
+}
+
+// MonitorCreateOpts represents the attributes used when creating a new monitor.
+type MonitorCreateOpts struct {
+	monitors.CreateOpts
+	MaxRetriesDown int      `json:"max_retries_down,omitempty"`
+	HTTPVersion    float64  `json:"http_version,omitempty"`
+	DomainName     string   `json:"domain_name,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+}
+
+// ToMonitorCreateMap casts a CreateOpts struct to a map.
+// It overrides monitors.ToMonitorCreateMap to add the MaxRetriesDown,
+// HTTPVersion, DomainName and Tags fields.
+func (opts MonitorCreateOpts) ToMonitorCreateMap() (map[string]interface{}, error) {
+	// Run the upstream validation of the HTTP(S) specific fields.
+	if _, err := opts.CreateOpts.ToMonitorCreateMap(); err != nil {
+		return nil, err
+	}
+
+	return BuildRequest(opts, "healthmonitor")
+}
+
+// MonitorUpdateOpts represents the attributes used when updating a monitor.
+// Name shadows the field of monitors.UpdateOpts so that it can be cleared.
+type MonitorUpdateOpts struct {
+	monitors.UpdateOpts
+	Name           *string   `json:"name,omitempty"`
+	MaxRetriesDown int       `json:"max_retries_down,omitempty"`
+	HTTPVersion    float64   `json:"http_version,omitempty"`
+	DomainName     *string   `json:"domain_name,omitempty"`
+	Tags           *[]string `json:"tags,omitempty"`
+}
+
+// ToMonitorUpdateMap casts an UpdateOpts struct to a map.
+// It overrides monitors.ToMonitorUpdateMap to add the MaxRetriesDown,
+// HTTPVersion, DomainName and Tags fields. An empty DomainName is sent as
+// null to clear it.
+func (opts MonitorUpdateOpts) ToMonitorUpdateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "healthmonitor")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DomainName != nil && *opts.DomainName == "" {
+		b["healthmonitor"].(map[string]interface{})["domain_name"] = nil
+	}
+
+	return b, nil
+}
+
 // NetworkCreateOpts represents the attributes used when creating a new network.
 type NetworkCreateOpts struct {
 	networks.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	QoSPolicyID         string            `json:"qos_policy_id,omitempty"`
+	DNSDomain           string            `json:"dns_domain,omitempty"`
+	MTU                 int               `json:"mtu,omitempty"`
+	PortSecurityEnabled *bool             `json:"port_security_enabled,omitempty"`
+	ValueSpecs          map[string]string `json:"value_specs,omitempty"`
 }
 
 // ToNetworkCreateMap casts a CreateOpts struct to a map.
-// It overrides networks.ToNetworkCreateMap to add the ValueSpecs field.
+// It overrides networks.ToNetworkCreateMap to add the QoSPolicyID, DNSDomain,
+// MTU, PortSecurityEnabled and ValueSpecs fields.
 func (opts NetworkCreateOpts) ToNetworkCreateMap() (map[string]interface{}, error) {
 	return BuildRequest(opts, "network")
 }
@@ -233,14 +425,80 @@ func (opts PolicyCreateOpts) ToFirewallPolicyCreateMap() (map[string]interface{}
 	return BuildRequest(opts, "firewall_policy")
 }
 
+// Pool is an LBaaS v2 pool, including the tags and backend TLS settings
+// which are only available with Octavia.
+type Pool struct {
+	pools.Pool
+	Tags              []string `json:"tags"`
+	TLSEnabled        bool     `json:"tls_enabled"`
+	TLSContainerRef   string   `json:"tls_container_ref"`
+	CATLSContainerRef string   `json:"ca_tls_container_ref"`
+	CRLContainerRef   string   `json:"crl_container_ref"`
+	TLSCiphers        string   `json:"tls_ciphers"`
+	TLSVersions       []string `json:"tls_versions"`
+	ALPNProtocols     []string `json:"alpn_protocols"`
+}
+
+// PoolCreateOpts represents the attributes used when creating a new pool.
+type PoolCreateOpts struct {
+	pools.CreateOpts
+	Tags              []string `json:"tags,omitempty"`
+	TLSEnabled        bool     `json:"tls_enabled,omitempty"`
+	TLSContainerRef   string   `json:"tls_container_ref,omitempty"`
+	CATLSContainerRef string   `json:"ca_tls_container_ref,omitempty"`
+	CRLContainerRef   string   `json:"crl_container_ref,omitempty"`
+	TLSCiphers        string   `json:"tls_ciphers,omitempty"`
+	TLSVersions       []string `json:"tls_versions,omitempty"`
+	ALPNProtocols     []string `json:"alpn_protocols,omitempty"`
+}
+
+// ToPoolCreateMap casts a CreateOpts struct to a map.
+// It overrides pools.ToPoolCreateMap to add the Tags and TLS fields.
+func (opts PoolCreateOpts) ToPoolCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "pool")
+}
+
+// PoolUpdateOpts represents the attributes used when updating a pool.
+type PoolUpdateOpts struct {
+	pools.UpdateOpts
+	Tags              *[]string `json:"tags,omitempty"`
+	TLSEnabled        *bool     `json:"tls_enabled,omitempty"`
+	TLSContainerRef   *string   `json:"tls_container_ref,omitempty"`
+	CATLSContainerRef *string   `json:"ca_tls_container_ref,omitempty"`
+	CRLContainerRef   *string   `json:"crl_container_ref,omitempty"`
+	TLSCiphers        *string   `json:"tls_ciphers,omitempty"`
+	TLSVersions       *[]string `json:"tls_versions,omitempty"`
+	ALPNProtocols     *[]string `json:"alpn_protocols,omitempty"`
+}
+
+// ToPoolUpdateMap casts an UpdateOpts struct to a map.
+// It overrides pools.ToPoolUpdateMap to add the Tags and TLS fields.
+func (opts PoolUpdateOpts) ToPoolUpdateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "pool")
+}
+
+// PortBindingOpts represents the attributes of the port binding extension
+// used when creating a new port.
+type PortBindingOpts struct {
+	HostID   string                 `json:"binding:host_id,omitempty"`
+	VNICType string                 `json:"binding:vnic_type,omitempty"`
+	Profile  map[string]interface{} `json:"binding:profile,omitempty"`
+}
+
 // PortCreateOpts represents the attributes used when creating a new port.
 type PortCreateOpts struct {
 	ports.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	PortBindingOpts
+	QoSPolicyID         string            `json:"qos_policy_id,omitempty"`
+	DNSName             string            `json:"dns_name,omitempty"`
+	DNSDomain           string            `json:"dns_domain,omitempty"`
+	PortSecurityEnabled *bool             `json:"port_security_enabled,omitempty"`
+	ValueSpecs          map[string]string `json:"value_specs,omitempty"`
 }
 
 // ToPortCreateMap casts a CreateOpts struct to a map.
-// It overrides ports.ToPortCreateMap to add the ValueSpecs field.
+// It overrides ports.ToPortCreateMap to add the binding, QoSPolicyID,
+// DNSName, DNSDomain, PortSecurityEnabled and ValueSpecs fields.
 func (opts PortCreateOpts) ToPortCreateMap() (map[string]interface{}, error) {
 	return BuildRequest(opts, "port")
 }
@@ -267,9 +525,26 @@ func (opts RecordSetCreateOpts) ToRecordSetCreateMap() (map[string]interface{},
 }
 
 // RouterCreateOpts represents the attributes used when creating a new router.
+// Its GatewayInfo overrides the one of routers.CreateOpts to add the external
+// fixed IPs.
 type RouterCreateOpts struct {
 	routers.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	GatewayInfo *RouterGatewayInfo `json:"external_gateway_info,omitempty"`
+	HA          *bool              `json:"ha,omitempty"`
+	ValueSpecs  map[string]string  `json:"value_specs,omitempty"`
+}
+
+// RouterGatewayInfo is the external gateway of a router, with the external
+// fixed IPs which the vendored gophercloud does not include.
+type RouterGatewayInfo struct {
+	NetworkID        string                  `json:"network_id"`
+	ExternalFixedIPs []RouterExternalFixedIP `json:"external_fixed_ips,omitempty"`
+}
+
+// RouterExternalFixedIP is an IP address of a router on its external network.
+type RouterExternalFixedIP struct {
+	SubnetID  string `json:"subnet_id,omitempty"`
+	IPAddress string `json:"ip_address,omitempty"`
 }
 
 // ToRouterCreateMap casts a CreateOpts struct to a map.
@@ -299,26 +574,250 @@ func (opts RuleCreateOpts) ToRuleCreateMap() (map[string]interface{}, error) {
 	return b, nil
 }
 
+// SecGroupRuleCreateOpts represents the attributes used when creating a new
+// security group rule.
+type SecGroupRuleCreateOpts struct {
+	secgrouprules.CreateOpts
+	Description string `json:"description,omitempty"`
+}
+
+// ToSecGroupRuleCreateMap casts a CreateOpts struct to a map.
+// It overrides rules.ToSecGroupRuleCreateMap to add the Description field.
+func (opts SecGroupRuleCreateOpts) ToSecGroupRuleCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "security_group_rule")
+}
+
 // ServerGroupCreateOpts represents the attributes used when creating a new router.
 type ServerGroupCreateOpts struct {
 	servergroups.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	ValueSpecs map[string]string      `json:"value_specs,omitempty"`
+	Rules      map[string]interface{} `json:"-"`
 }
 
 // ToServerGroupCreateMap casts a CreateOpts struct to a map.
-// It overrides routers.ToServerGroupCreateMap to add the ValueSpecs field.
+// It overrides routers.ToServerGroupCreateMap to add the ValueSpecs field
+// and, when Rules are set, to use the single policy of microversion 2.64.
 func (opts ServerGroupCreateOpts) ToServerGroupCreateMap() (map[string]interface{}, error) {
-	return BuildRequest(opts, "server_group")
+	b, err := BuildRequest(opts, "server_group")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.Rules) > 0 && len(opts.Policies) > 0 {
+		m := b["server_group"].(map[string]interface{})
+		m["policy"] = opts.Policies[0]
+		m["rules"] = opts.Rules
+		delete(m, "policies")
+	}
+
+	return b, nil
+}
+
+// InstanceDeviceTagsCreateOptsExt adds the device tags of the networks and
+// block devices of an instance to its creation options. The tags are matched
+// with the networks and block devices by position, and empty tags are
+// omitted.
+type InstanceDeviceTagsCreateOptsExt struct {
+	servers.CreateOptsBuilder
+	NetworkTags     []string
+	BlockDeviceTags []string
+}
+
+// ToServerCreateMap adds the device tags to the base server creation options.
+func (opts InstanceDeviceTagsCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	serverMap := base["server"].(map[string]interface{})
+	addTags := func(key string, tags []string) {
+		devices, ok := serverMap[key].([]map[string]interface{})
+		if !ok {
+			return
+		}
+		for i, tag := range tags {
+			if tag != "" && i < len(devices) {
+				devices[i]["tag"] = tag
+			}
+		}
+	}
+
+	addTags("networks", opts.NetworkTags)
+	addTags("block_device_mapping_v2", opts.BlockDeviceTags)
+
+	return base, nil
+}
+
+// InstanceBlockDeviceVolumeTypesCreateOptsExt adds the volume types of the
+// volumes Nova creates for the block devices of an instance to its creation
+// options. The volume types are matched with the block devices by position,
+// and empty ones are omitted.
+type InstanceBlockDeviceVolumeTypesCreateOptsExt struct {
+	servers.CreateOptsBuilder
+	VolumeTypes []string
+}
+
+// ToServerCreateMap adds the volume types to the base server creation
+// options.
+func (opts InstanceBlockDeviceVolumeTypesCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	serverMap := base["server"].(map[string]interface{})
+	devices, ok := serverMap["block_device_mapping_v2"].([]map[string]interface{})
+	if !ok {
+		return base, nil
+	}
+
+	for i, volumeType := range opts.VolumeTypes {
+		if volumeType != "" && i < len(devices) {
+			devices[i]["volume_type"] = volumeType
+		}
+	}
+
+	return base, nil
+}
+
+// InstanceHostnameCreateOptsExt adds the hostname of an instance to its
+// creation options.
+type InstanceHostnameCreateOptsExt struct {
+	servers.CreateOptsBuilder
+	Hostname string
+}
+
+// ToServerCreateMap adds the hostname to the base server creation options.
+func (opts InstanceHostnameCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	serverMap := base["server"].(map[string]interface{})
+	serverMap["hostname"] = opts.Hostname
+
+	return base, nil
+}
+
+// InstanceUpdateOpts represents the attributes used when updating an
+// instance. It adds the hostname to servers.UpdateOpts.
+type InstanceUpdateOpts struct {
+	servers.UpdateOpts
+	Hostname string `json:"hostname,omitempty"`
+}
+
+// ToServerUpdateMap casts an InstanceUpdateOpts struct to a map.
+func (opts InstanceUpdateOpts) ToServerUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "server")
+}
+
+// InstanceRescueOpts represents the options used to rescue an instance.
+type InstanceRescueOpts struct {
+	AdminPass      string `json:"adminPass,omitempty"`
+	RescueImageRef string `json:"rescue_image_ref,omitempty"`
+}
+
+// InstanceSchedulerHints adds hints which aren't known to
+// schedulerhints.SchedulerHints, such as those of custom scheduler filters.
+type InstanceSchedulerHints struct {
+	schedulerhints.SchedulerHints
+	AdditionalProperties map[string]interface{}
+}
+
+// ToServerSchedulerHintsCreateMap adds the additional hints to the known
+// ones, which take precedence.
+func (opts InstanceSchedulerHints) ToServerSchedulerHintsCreateMap() (map[string]interface{}, error) {
+	sh, err := opts.SchedulerHints.ToServerSchedulerHintsCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range opts.AdditionalProperties {
+		if _, ok := sh[k]; !ok {
+			sh[k] = v
+		}
+	}
+
+	return sh, nil
+}
+
+// VolumeCreateOpts represents the attributes used when creating a new volume.
+type VolumeCreateOpts struct {
+	volumes.CreateOpts
+	Multiattach bool `json:"multiattach,omitempty"`
+}
+
+// ToVolumeCreateMap casts a CreateOpts struct to a map.
+// It overrides volumes.ToVolumeCreateMap to add the Multiattach field.
+func (opts VolumeCreateOpts) ToVolumeCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "volume")
+}
+
+// VolumeCreateOptsBuilder is satisfied by the CreateOpts of both the v1 and
+// v2 volumes packages.
+type VolumeCreateOptsBuilder interface {
+	ToVolumeCreateMap() (map[string]interface{}, error)
+}
+
+// VolumeSchedulerHints represents the scheduler hints of a volume.
+type VolumeSchedulerHints struct {
+	DifferentHost   []string `json:"different_host,omitempty"`
+	SameHost        []string `json:"same_host,omitempty"`
+	LocalToInstance string   `json:"local_to_instance,omitempty"`
+}
+
+// VolumeSchedulerHintsCreateOptsExt adds scheduler hints to the options
+// used to create a volume.
+type VolumeSchedulerHintsCreateOptsExt struct {
+	VolumeCreateOptsBuilder
+	SchedulerHints VolumeSchedulerHints
+}
+
+// ToVolumeCreateMap adds the scheduler hints to the request body, next to
+// the volume.
+func (opts VolumeSchedulerHintsCreateOptsExt) ToVolumeCreateMap() (map[string]interface{}, error) {
+	base, err := opts.VolumeCreateOptsBuilder.ToVolumeCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	hints, err := gophercloud.BuildRequestBody(opts.SchedulerHints, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hints) > 0 {
+		base["OS-SCH-HNT:scheduler_hints"] = hints
+	}
+
+	return base, nil
+}
+
+// VolumeAttachCreateOpts represents the attributes used when attaching a
+// volume to an instance.
+type VolumeAttachCreateOpts struct {
+	volumeattach.CreateOpts
+	Tag string `json:"tag,omitempty"`
+}
+
+// ToVolumeAttachmentCreateMap casts a CreateOpts struct to a map.
+// It overrides volumeattach.ToVolumeAttachmentCreateMap to add the Tag field.
+func (opts VolumeAttachCreateOpts) ToVolumeAttachmentCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "volumeAttachment")
 }
 
 // SubnetCreateOpts represents the attributes used when creating a new subnet.
 type SubnetCreateOpts struct {
 	subnets.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	ValueSpecs   map[string]string `json:"value_specs,omitempty"`
+	SubnetPoolID string            `json:"subnetpool_id,omitempty"`
 }
 
 // ToSubnetCreateMap casts a CreateOpts struct to a map.
-// It overrides subnets.ToSubnetCreateMap to add the ValueSpecs field.
+// It overrides subnets.ToSubnetCreateMap to add the ValueSpecs and
+// SubnetPoolID fields.
 func (opts SubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error) {
 	b, err := BuildRequest(opts, "subnet")
 	if err != nil {
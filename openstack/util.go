@@ -12,6 +12,14 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// JSONPatch is a JSON patch (RFC 6902) operation, which some OpenStack APIs,
+// e.g. Bare Metal and Watcher, use to update resources.
+type JSONPatch struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
 // BuildRequest takes an opts struct and builds a request body for
 // Gophercloud to execute
 func BuildRequest(opts interface{}, parent string) (map[string]interface{}, error) {
@@ -98,3 +106,83 @@ func FormatHeaders(headers http.Header, seperator string) string {
 
 	return strings.Join(redactedHeaders, seperator)
 }
+
+// MergeDefaultMetadata returns metadata with the provider's default_metadata
+// added. Keys set on the resource take precedence over the defaults.
+func MergeDefaultMetadata(config *Config, ignoreDefaults bool, metadata map[string]string) map[string]string {
+	m := make(map[string]string)
+	if !ignoreDefaults {
+		for k, v := range config.DefaultMetadata {
+			m[k] = v
+		}
+	}
+	for k, v := range metadata {
+		m[k] = v
+	}
+	return m
+}
+
+// StripDefaultMetadata removes the provider's default_metadata from metadata
+// returned by the API, unless the key is also set on the resource. This keeps
+// the defaults from showing up as a diff.
+func StripDefaultMetadata(config *Config, ignoreDefaults bool, metadata, configured map[string]string) map[string]string {
+	m := make(map[string]string)
+	for k, v := range metadata {
+		if _, ok := configured[k]; !ok && !ignoreDefaults {
+			if dv, ok := config.DefaultMetadata[k]; ok && dv == v {
+				continue
+			}
+		}
+		m[k] = v
+	}
+	return m
+}
+
+// MergeDefaultTags returns tags with the provider's default_tags added.
+func MergeDefaultTags(config *Config, ignoreDefaults bool, tags []string) []string {
+	t := make([]string, 0, len(tags)+len(config.DefaultTags))
+	t = append(t, tags...)
+	if ignoreDefaults {
+		return t
+	}
+	for _, tag := range config.DefaultTags {
+		if !strSliceContains(t, tag) {
+			t = append(t, tag)
+		}
+	}
+	return t
+}
+
+// StripDefaultTags removes the provider's default_tags from tags returned by
+// the API, unless the tag is also set on the resource.
+func StripDefaultTags(config *Config, ignoreDefaults bool, tags, configured []string) []string {
+	t := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !ignoreDefaults && strSliceContains(config.DefaultTags, tag) && !strSliceContains(configured, tag) {
+			continue
+		}
+		t = append(t, tag)
+	}
+	return t
+}
+
+func strSliceContains(s []string, v string) bool {
+	for _, i := range s {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func strSliceEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
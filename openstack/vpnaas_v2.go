@@ -0,0 +1,58 @@
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// VPNaaSSiteConnection is an IPsec site connection.
+type VPNaaSSiteConnection struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	TenantID       string   `json:"tenant_id"`
+	Status         string   `json:"status"`
+	AdminStateUp   bool     `json:"admin_state_up"`
+	PeerAddress    string   `json:"peer_address"`
+	PeerID         string   `json:"peer_id"`
+	PeerCIDRs      []string `json:"peer_cidrs"`
+	RouteMode      string   `json:"route_mode"`
+	MTU            int      `json:"mtu"`
+	Initiator      string   `json:"initiator"`
+	AuthMode       string   `json:"auth_mode"`
+	VPNServiceID   string   `json:"vpnservice_id"`
+	IKEPolicyID    string   `json:"ikepolicy_id"`
+	IPSecPolicyID  string   `json:"ipsecpolicy_id"`
+	LocalEPGroupID string   `json:"local_ep_group_id"`
+	PeerEPGroupID  string   `json:"peer_ep_group_id"`
+	DPD            struct {
+		Action   string `json:"action"`
+		Interval int    `json:"interval"`
+		Timeout  int    `json:"timeout"`
+	} `json:"dpd"`
+}
+
+// VPNaaSSiteConnectionListOpts represents the query parameters used when
+// listing IPsec site connections.
+type VPNaaSSiteConnectionListOpts struct {
+	ID           string `q:"id"`
+	Name         string `q:"name"`
+	TenantID     string `q:"tenant_id"`
+	VPNServiceID string `q:"vpnservice_id"`
+}
+
+func vpnaasSiteConnectionV2List(client *gophercloud.ServiceClient, opts VPNaaSSiteConnectionListOpts) ([]VPNaaSSiteConnection, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Connections []VPNaaSSiteConnection `json:"ipsec_site_connections"`
+	}
+	_, err = client.Get(client.ServiceURL("vpn", "ipsec-site-connections")+q.String(), &res, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Connections, nil
+}